@@ -0,0 +1,114 @@
+package corestream
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ListAlertGroups returns a page of alert groups. opts may be nil.
+func (c *Client) ListAlertGroups(ctx context.Context, opts *ListAlertGroupsOptions) (*ListAlertGroupsResponse, error) {
+	if opts == nil {
+		opts = &ListAlertGroupsOptions{}
+	}
+
+	query := url.Values{}
+	if opts.Page > 0 {
+		query.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.PageSize > 0 {
+		query.Set("page_size", strconv.Itoa(opts.PageSize))
+	}
+
+	var resp ListAlertGroupsResponse
+	if err := c.request(ctx, http.MethodGet, "/v2/alert-groups", query, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAlertGroupsPage returns a single page of alert groups that can fetch the
+// pages after it. opts may be nil.
+func (c *Client) ListAlertGroupsPage(ctx context.Context, opts *ListAlertGroupsOptions) (*Page[AlertGroup], error) {
+	base := ListAlertGroupsOptions{}
+	if opts != nil {
+		base = *opts
+	}
+	return fetchPage(ctx, base.Page, func(ctx context.Context, page int) ([]AlertGroup, Pagination, error) {
+		o := base
+		o.Page = page
+		resp, err := c.ListAlertGroups(ctx, &o)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+		return resp.AlertGroups, resp.Pagination, nil
+	})
+}
+
+// CreateAlertGroup creates a new alert group.
+func (c *Client) CreateAlertGroup(ctx context.Context, req *CreateAlertGroupRequest) (*AlertGroup, error) {
+	var group AlertGroup
+	if err := c.request(ctx, http.MethodPost, "/v2/alert-groups", nil, req, &group); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// GetAlertGroup retrieves a specific alert group by ID.
+func (c *Client) GetAlertGroup(ctx context.Context, groupID string) (*AlertGroup, error) {
+	path := fmt.Sprintf("/v2/alert-groups/%s", groupID)
+	var group AlertGroup
+	if err := c.request(ctx, http.MethodGet, path, nil, nil, &group); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// UpdateAlertGroup updates an existing alert group.
+func (c *Client) UpdateAlertGroup(ctx context.Context, groupID string, req *UpdateAlertGroupRequest) (*AlertGroup, error) {
+	path := fmt.Sprintf("/v2/alert-groups/%s", groupID)
+	var group AlertGroup
+	if err := c.request(ctx, http.MethodPut, path, nil, req, &group); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// DeleteAlertGroup deletes an alert group. The alerts in the group are kept.
+func (c *Client) DeleteAlertGroup(ctx context.Context, groupID string) error {
+	path := fmt.Sprintf("/v2/alert-groups/%s", groupID)
+	return c.request(ctx, http.MethodDelete, path, nil, nil, nil)
+}
+
+// AddAlertsToGroup assigns alerts to a group. An alert belongs to at most one
+// group, so alerts are moved out of any group they were in before.
+func (c *Client) AddAlertsToGroup(ctx context.Context, groupID string, alertIDs ...string) (*AlertGroup, error) {
+	path := fmt.Sprintf("/v2/alert-groups/%s/alerts", groupID)
+	var group AlertGroup
+	if err := c.request(ctx, http.MethodPost, path, nil, &AlertGroupMembersRequest{AlertIDs: alertIDs}, &group); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// RemoveAlertsFromGroup removes alerts from a group without deleting them.
+func (c *Client) RemoveAlertsFromGroup(ctx context.Context, groupID string, alertIDs ...string) (*AlertGroup, error) {
+	path := fmt.Sprintf("/v2/alert-groups/%s/alerts/remove", groupID)
+	var group AlertGroup
+	if err := c.request(ctx, http.MethodPost, path, nil, &AlertGroupMembersRequest{AlertIDs: alertIDs}, &group); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// SetAlertGroupActive enables or disables every alert in a group.
+func (c *Client) SetAlertGroupActive(ctx context.Context, groupID string, active bool) (*BulkUpdateResponse, error) {
+	path := fmt.Sprintf("/v2/alert-groups/%s/active", groupID)
+	var resp BulkUpdateResponse
+	if err := c.request(ctx, http.MethodPut, path, nil, &SetAlertGroupActiveRequest{IsActive: active}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
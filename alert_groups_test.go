@@ -0,0 +1,180 @@
+package corestream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestListAlertGroups(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/v2/alert-groups" {
+			t.Errorf("expected path /v2/alert-groups, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("page_size") != "50" {
+			t.Errorf("expected page_size=50, got %s", r.URL.Query().Get("page_size"))
+		}
+		json.NewEncoder(w).Encode(ListAlertGroupsResponse{
+			AlertGroups: []AlertGroup{{ID: "group_1", Name: "Acme", AlertIDs: []string{"alert_1", "alert_2"}}},
+			Pagination:  Pagination{Page: 1, PageSize: 50, TotalItems: 1, TotalPages: 1},
+		})
+	})
+	defer server.Close()
+
+	resp, err := client.ListAlertGroups(context.Background(), &ListAlertGroupsOptions{PageSize: 50})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.AlertGroups) != 1 || len(resp.AlertGroups[0].AlertIDs) != 2 {
+		t.Errorf("unexpected groups %+v", resp.AlertGroups)
+	}
+}
+
+func TestListAlertGroupsPage(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		page = max(page, 1)
+		json.NewEncoder(w).Encode(ListAlertGroupsResponse{
+			AlertGroups: []AlertGroup{{ID: fmt.Sprintf("group_%d", page)}},
+			Pagination:  Pagination{Page: page, PageSize: 1, TotalItems: 2, TotalPages: 2},
+		})
+	})
+	defer server.Close()
+
+	ctx := context.Background()
+	page, err := client.ListAlertGroupsPage(ctx, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].ID != "group_1" {
+		t.Errorf("unexpected first page %+v", page.Items)
+	}
+	if !page.HasNext() {
+		t.Fatal("expected a next page")
+	}
+
+	next, err := page.NextPage(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(next.Items) != 1 || next.Items[0].ID != "group_2" {
+		t.Errorf("unexpected second page %+v", next.Items)
+	}
+	if next.HasNext() {
+		t.Error("expected no page after the last")
+	}
+}
+
+func TestCreateAlertGroup(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		var req CreateAlertGroupRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.Name != "Acme" || len(req.AlertIDs) != 1 {
+			t.Errorf("unexpected request %+v", req)
+		}
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(AlertGroup{ID: "group_1", Name: req.Name, AlertIDs: req.AlertIDs})
+	})
+	defer server.Close()
+
+	group, err := client.CreateAlertGroup(context.Background(), &CreateAlertGroupRequest{Name: "Acme", AlertIDs: []string{"alert_1"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if group.ID != "group_1" {
+		t.Errorf("expected group ID 'group_1', got %s", group.ID)
+	}
+}
+
+func TestAlertGroupMembership(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		call func(c *Client) (*AlertGroup, error)
+	}{
+		{"add", "/v2/alert-groups/group_1/alerts", func(c *Client) (*AlertGroup, error) {
+			return c.AddAlertsToGroup(context.Background(), "group_1", "alert_1", "alert_2")
+		}},
+		{"remove", "/v2/alert-groups/group_1/alerts/remove", func(c *Client) (*AlertGroup, error) {
+			return c.RemoveAlertsFromGroup(context.Background(), "group_1", "alert_1", "alert_2")
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("expected POST, got %s", r.Method)
+				}
+				if r.URL.Path != tt.path {
+					t.Errorf("expected path %s, got %s", tt.path, r.URL.Path)
+				}
+				var req AlertGroupMembersRequest
+				json.NewDecoder(r.Body).Decode(&req)
+				if len(req.AlertIDs) != 2 {
+					t.Errorf("expected 2 alert IDs, got %v", req.AlertIDs)
+				}
+				json.NewEncoder(w).Encode(AlertGroup{ID: "group_1"})
+			})
+			defer server.Close()
+
+			if _, err := tt.call(client); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestSetAlertGroupActive(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/v2/alert-groups/group_1/active" {
+			t.Errorf("expected path /v2/alert-groups/group_1/active, got %s", r.URL.Path)
+		}
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["is_active"] != false {
+			t.Errorf("expected is_active=false, got %v", body["is_active"])
+		}
+		json.NewEncoder(w).Encode(BulkUpdateResponse{Updated: 12})
+	})
+	defer server.Close()
+
+	resp, err := client.SetAlertGroupActive(context.Background(), "group_1", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Updated != 12 {
+		t.Errorf("expected 12 updated, got %d", resp.Updated)
+	}
+}
+
+func TestDeleteAlertGroup(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		if r.URL.Path != "/v2/alert-groups/group_1" {
+			t.Errorf("expected path /v2/alert-groups/group_1, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer server.Close()
+
+	if err := client.DeleteAlertGroup(context.Background(), "group_1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -3,19 +3,106 @@ package corestream
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+	"time"
 )
 
+// ActiveAt reports whether the alert fires at time t, taking IsActive,
+// archival, and the alert's schedule into account.
+func (a *Alert) ActiveAt(t time.Time) (bool, error) {
+	if !a.IsActive || a.ArchivedAt != nil {
+		return false, nil
+	}
+	if a.Schedule == nil {
+		return true, nil
+	}
+	return a.Schedule.Active(t)
+}
+
 // ListAlerts returns all alerts for the authenticated user.
+// New code should use ListAlertsWithOptions.
 func (c *Client) ListAlerts(ctx context.Context, page, pageSize int) (*ListAlertsResponse, error) {
+	return c.listAlerts(ctx, &ListAlertsOptions{Page: page, PageSize: pageSize})
+}
+
+// ListAlertsWithOptions returns a page of alerts for the authenticated user.
+// opts may be nil.
+func (c *Client) ListAlertsWithOptions(ctx context.Context, opts *ListAlertsOptions) (*ListAlertsResponse, error) {
+	if opts == nil {
+		opts = &ListAlertsOptions{}
+	}
+	return c.listAlerts(ctx, opts)
+}
+
+// ListAlertsPage returns a single page of alerts that can fetch the pages after it.
+// opts may be nil.
+func (c *Client) ListAlertsPage(ctx context.Context, opts *ListAlertsOptions) (*Page[Alert], error) {
+	startPage, fetch := c.alertsFetcher(opts)
+	return fetchPage(ctx, startPage, fetch)
+}
+
+// AlertsIterator returns an iterator over all alerts, fetching pages as needed.
+// Iteration starts at opts.Page (or the first page) and stops at the first error.
+// opts may be nil.
+func (c *Client) AlertsIterator(ctx context.Context, opts *ListAlertsOptions, popts ...PaginationOption) iter.Seq2[Alert, error] {
+	startPage, fetch := c.alertsFetcher(opts)
+	return paginate(ctx, startPage, fetch, popts...)
+}
+
+func (c *Client) alertsFetcher(opts *ListAlertsOptions) (int, pageFetcher[Alert]) {
+	base := ListAlertsOptions{}
+	if opts != nil {
+		base = *opts
+	}
+	return base.Page, func(ctx context.Context, page int) ([]Alert, Pagination, error) {
+		o := base
+		o.Page = page
+		resp, err := c.listAlerts(ctx, &o)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+		return resp.Alerts, resp.Pagination, nil
+	}
+}
+
+// ListAllAlerts fetches every page of alerts and returns them as a single slice.
+// Use WithMaxItems to cap the number of alerts fetched. opts may be nil.
+func (c *Client) ListAllAlerts(ctx context.Context, opts *ListAlertsOptions, popts ...PaginationOption) ([]Alert, error) {
+	return collect(c.AlertsIterator(ctx, opts, popts...))
+}
+
+func (c *Client) listAlerts(ctx context.Context, opts *ListAlertsOptions) (*ListAlertsResponse, error) {
 	query := url.Values{}
-	if page > 0 {
-		query.Set("page", strconv.Itoa(page))
+	if opts.Page > 0 {
+		query.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.PageSize > 0 {
+		query.Set("page_size", strconv.Itoa(opts.PageSize))
 	}
-	if pageSize > 0 {
-		query.Set("page_size", strconv.Itoa(pageSize))
+	setSort(query, string(opts.SortBy), opts.SortOrder)
+	if opts.IsActive != nil {
+		query.Set("is_active", strconv.FormatBool(*opts.IsActive))
+	}
+	if opts.NameContains != "" {
+		query.Set("name_contains", opts.NameContains)
+	}
+	if opts.Phrase != "" {
+		query.Set("phrase", opts.Phrase)
+	}
+	setTime(query, "created_after", opts.CreatedAfter)
+	setTime(query, "created_before", opts.CreatedBefore)
+	for _, tag := range opts.Tags {
+		query.Add("tag", tag)
+	}
+	if opts.Archived != nil {
+		query.Set("archived", strconv.FormatBool(*opts.Archived))
+	}
+	if opts.IncludeWebhook {
+		query.Set("include", "webhook")
 	}
 
 	var resp ListAlertsResponse
@@ -54,22 +141,153 @@ func (c *Client) UpdateAlert(ctx context.Context, alertID string, req *UpdateAle
 	return &alert, nil
 }
 
+// SetAlertActive enables or disables an alert without changing anything else.
+func (c *Client) SetAlertActive(ctx context.Context, alertID string, active bool) (*Alert, error) {
+	return c.UpdateAlert(ctx, alertID, &UpdateAlertRequest{IsActive: &active})
+}
+
+// ArchiveAlert retires an alert without deleting it. Archived alerts stop
+// matching and are hidden from listings by default, but their notifications
+// remain available.
+func (c *Client) ArchiveAlert(ctx context.Context, alertID string) (*Alert, error) {
+	path := fmt.Sprintf("/v2/alerts/%s/archive", alertID)
+	var alert Alert
+	if err := c.request(ctx, http.MethodPost, path, nil, nil, &alert); err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+// UnarchiveAlert restores an archived alert. The alert is restored inactive.
+func (c *Client) UnarchiveAlert(ctx context.Context, alertID string) (*Alert, error) {
+	path := fmt.Sprintf("/v2/alerts/%s/unarchive", alertID)
+	var alert Alert
+	if err := c.request(ctx, http.MethodPost, path, nil, nil, &alert); err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
 // DeleteAlert permanently deletes an alert.
 func (c *Client) DeleteAlert(ctx context.Context, alertID string) error {
 	path := fmt.Sprintf("/v2/alerts/%s", alertID)
 	return c.request(ctx, http.MethodDelete, path, nil, nil, nil)
 }
 
+// GetAlertHistory returns the alert's change history, newest first.
+func (c *Client) GetAlertHistory(ctx context.Context, alertID string) (*AlertHistoryResponse, error) {
+	path := fmt.Sprintf("/v2/alerts/%s/history", alertID)
+	var resp AlertHistoryResponse
+	if err := c.request(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// TestAlert reports where the alert's phrases would match in sampleText,
+// without creating notifications or delivering webhooks.
+func (c *Client) TestAlert(ctx context.Context, alertID, sampleText string) (*TestAlertResponse, error) {
+	if strings.TrimSpace(sampleText) == "" {
+		return nil, fmt.Errorf("corestream: sample text cannot be empty")
+	}
+	return c.testAlert(ctx, alertID, &TestAlertRequest{Text: sampleText})
+}
+
+// TestAlertAgainstStream reports where the alert's phrases would match in
+// the transcript of an existing stream, without creating notifications or
+// delivering webhooks.
+func (c *Client) TestAlertAgainstStream(ctx context.Context, alertID, streamID string) (*TestAlertResponse, error) {
+	if streamID == "" {
+		return nil, fmt.Errorf("corestream: stream ID cannot be empty")
+	}
+	return c.testAlert(ctx, alertID, &TestAlertRequest{StreamID: streamID})
+}
+
+func (c *Client) testAlert(ctx context.Context, alertID string, req *TestAlertRequest) (*TestAlertResponse, error) {
+	path := fmt.Sprintf("/v2/alerts/%s/test", alertID)
+	var resp TestAlertResponse
+	if err := c.request(ctx, http.MethodPost, path, nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // GetAlertNotifications retrieves notifications for a specific alert.
+// New code should use GetAlertNotificationsWithOptions.
 func (c *Client) GetAlertNotifications(ctx context.Context, alertID string, page, pageSize int) (*ListNotificationsResponse, error) {
+	return c.listNotifications(ctx, alertID, &ListNotificationsOptions{Page: page, PageSize: pageSize})
+}
+
+// GetAlertNotificationsWithOptions retrieves a page of notifications for a
+// specific alert. opts may be nil.
+func (c *Client) GetAlertNotificationsWithOptions(ctx context.Context, alertID string, opts *ListNotificationsOptions) (*ListNotificationsResponse, error) {
+	if opts == nil {
+		opts = &ListNotificationsOptions{}
+	}
+	return c.listNotifications(ctx, alertID, opts)
+}
+
+// ListNotificationsPage returns a single page of notifications for an alert
+// that can fetch the pages after it. opts may be nil.
+func (c *Client) ListNotificationsPage(ctx context.Context, alertID string, opts *ListNotificationsOptions) (*Page[Notification], error) {
+	startPage, fetch := c.notificationsFetcher(alertID, opts)
+	return fetchPage(ctx, startPage, fetch)
+}
+
+// NotificationsIterator returns an iterator over all notifications for an alert,
+// fetching pages as needed. opts may be nil.
+func (c *Client) NotificationsIterator(ctx context.Context, alertID string, opts *ListNotificationsOptions, popts ...PaginationOption) iter.Seq2[Notification, error] {
+	startPage, fetch := c.notificationsFetcher(alertID, opts)
+	return paginate(ctx, startPage, fetch, popts...)
+}
+
+func (c *Client) notificationsFetcher(alertID string, opts *ListNotificationsOptions) (int, pageFetcher[Notification]) {
+	base := ListNotificationsOptions{}
+	if opts != nil {
+		base = *opts
+	}
+	return base.Page, func(ctx context.Context, page int) ([]Notification, Pagination, error) {
+		o := base
+		o.Page = page
+		resp, err := c.listNotifications(ctx, alertID, &o)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+		return resp.Notifications, resp.Pagination, nil
+	}
+}
+
+// ListAllNotifications fetches every page of notifications for an alert and
+// returns them as a single slice. Use WithMaxItems to cap the number of
+// notifications fetched. opts may be nil.
+func (c *Client) ListAllNotifications(ctx context.Context, alertID string, opts *ListNotificationsOptions, popts ...PaginationOption) ([]Notification, error) {
+	return collect(c.NotificationsIterator(ctx, alertID, opts, popts...))
+}
+
+func (c *Client) listNotifications(ctx context.Context, alertID string, opts *ListNotificationsOptions) (*ListNotificationsResponse, error) {
 	path := fmt.Sprintf("/v2/alerts/%s/notifications", alertID)
 
 	query := url.Values{}
-	if page > 0 {
-		query.Set("page", strconv.Itoa(page))
+	if opts.Page > 0 {
+		query.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.PageSize > 0 {
+		query.Set("page_size", strconv.Itoa(opts.PageSize))
+	}
+	setSort(query, string(opts.SortBy), opts.SortOrder)
+	setTime(query, "since", opts.Since)
+	setTime(query, "until", opts.Until)
+	if opts.MatchedPhrase != "" {
+		query.Set("matched_phrase", opts.MatchedPhrase)
+	}
+	if opts.StreamerID != "" {
+		query.Set("streamer_id", opts.StreamerID)
+	}
+	if opts.StreamID != "" {
+		query.Set("stream_id", opts.StreamID)
 	}
-	if pageSize > 0 {
-		query.Set("page_size", strconv.Itoa(pageSize))
+	if opts.Unread {
+		query.Set("unread", "true")
 	}
 
 	var resp ListNotificationsResponse
@@ -78,3 +296,15 @@ func (c *Client) GetAlertNotifications(ctx context.Context, alertID string, page
 	}
 	return &resp, nil
 }
+
+// AckNotification marks a notification as acknowledged (read) and returns the
+// updated notification. Acknowledging an already acknowledged notification is
+// a no-op.
+func (c *Client) AckNotification(ctx context.Context, notificationID string) (*Notification, error) {
+	path := fmt.Sprintf("/v2/notifications/%s/ack", notificationID)
+	var notification Notification
+	if err := c.request(ctx, http.MethodPost, path, nil, nil, &notification); err != nil {
+		return nil, err
+	}
+	return &notification, nil
+}
@@ -1,6 +1,7 @@
 package corestream
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"net/http"
@@ -289,3 +290,730 @@ func TestGetAlertNotifications(t *testing.T) {
 		t.Errorf("expected notification ID 'notif_456', got %s", result.Notifications[0].ID)
 	}
 }
+
+func TestAlertsIterator(t *testing.T) {
+	t.Run("walks all pages", func(t *testing.T) {
+		var pages []string
+		client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			page := r.URL.Query().Get("page")
+			pages = append(pages, page)
+			if r.URL.Query().Get("page_size") != "2" {
+				t.Errorf("expected page_size=2, got %s", r.URL.Query().Get("page_size"))
+			}
+
+			resp := ListAlertsResponse{
+				Pagination: Pagination{PageSize: 2, TotalItems: 3, TotalPages: 2},
+			}
+			switch page {
+			case "1":
+				resp.Alerts = []Alert{{ID: "alert_1"}, {ID: "alert_2"}}
+				resp.Pagination.Page = 1
+			case "2":
+				resp.Alerts = []Alert{{ID: "alert_3"}}
+				resp.Pagination.Page = 2
+			default:
+				t.Errorf("unexpected page %s", page)
+			}
+			json.NewEncoder(w).Encode(resp)
+		})
+		defer server.Close()
+
+		var ids []string
+		for alert, err := range client.AlertsIterator(context.Background(), &ListAlertsOptions{PageSize: 2}) {
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			ids = append(ids, alert.ID)
+		}
+
+		if len(ids) != 3 || ids[0] != "alert_1" || ids[2] != "alert_3" {
+			t.Errorf("unexpected alerts %v", ids)
+		}
+		if len(pages) != 2 {
+			t.Errorf("expected 2 page requests, got %d", len(pages))
+		}
+	})
+
+	t.Run("stops early", func(t *testing.T) {
+		requests := 0
+		client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			resp := ListAlertsResponse{
+				Alerts:     []Alert{{ID: "alert_1"}, {ID: "alert_2"}},
+				Pagination: Pagination{Page: 1, PageSize: 2, TotalItems: 10, TotalPages: 5},
+			}
+			json.NewEncoder(w).Encode(resp)
+		})
+		defer server.Close()
+
+		for range client.AlertsIterator(context.Background(), nil) {
+			break
+		}
+		if requests != 1 {
+			t.Errorf("expected 1 request, got %d", requests)
+		}
+	})
+
+	t.Run("yields error", func(t *testing.T) {
+		client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":{"code":"unauthorized","message":"Invalid token"}}`))
+		})
+		defer server.Close()
+
+		var gotErr error
+		for _, err := range client.AlertsIterator(context.Background(), nil) {
+			gotErr = err
+		}
+		if !IsUnauthorized(gotErr) {
+			t.Errorf("expected unauthorized error, got %v", gotErr)
+		}
+	})
+
+	t.Run("respects context cancellation", func(t *testing.T) {
+		client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			t.Error("no request expected")
+		})
+		defer server.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		var gotErr error
+		for _, err := range client.AlertsIterator(ctx, nil) {
+			gotErr = err
+		}
+		if gotErr != context.Canceled {
+			t.Errorf("expected context.Canceled, got %v", gotErr)
+		}
+	})
+}
+
+func TestListAllAlerts(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		resp := ListAlertsResponse{
+			Alerts:     []Alert{{ID: "alert_" + page + "a"}, {ID: "alert_" + page + "b"}},
+			Pagination: Pagination{PageSize: 2, TotalItems: 6, TotalPages: 3},
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+	defer server.Close()
+
+	ctx := context.Background()
+
+	t.Run("all pages", func(t *testing.T) {
+		alerts, err := client.ListAllAlerts(ctx, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(alerts) != 6 {
+			t.Fatalf("expected 6 alerts, got %d", len(alerts))
+		}
+		if alerts[5].ID != "alert_3b" {
+			t.Errorf("expected last alert 'alert_3b', got %s", alerts[5].ID)
+		}
+	})
+
+	t.Run("max items", func(t *testing.T) {
+		alerts, err := client.ListAllAlerts(ctx, nil, WithMaxItems(3))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(alerts) != 3 {
+			t.Fatalf("expected 3 alerts, got %d", len(alerts))
+		}
+	})
+}
+
+func TestListAllNotifications(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/alerts/alert_123/notifications" {
+			t.Errorf("expected path /v2/alerts/alert_123/notifications, got %s", r.URL.Path)
+		}
+		page := r.URL.Query().Get("page")
+		resp := ListNotificationsResponse{
+			Notifications: []Notification{{ID: "notif_" + page}},
+			Pagination:    Pagination{PageSize: 1, TotalItems: 2, TotalPages: 2},
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+	defer server.Close()
+
+	notifications, err := client.ListAllNotifications(context.Background(), "alert_123", &ListNotificationsOptions{PageSize: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(notifications) != 2 || notifications[1].ID != "notif_2" {
+		t.Errorf("unexpected notifications %+v", notifications)
+	}
+}
+
+func TestListAlertsPage_IncludeWebhook(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("include") != "webhook" {
+			t.Errorf("expected include=webhook, got %s", r.URL.Query().Get("include"))
+		}
+		w.Write([]byte(`{
+			"alerts": [
+				{"id": "alert_1", "webhook": {"configured": true, "is_active": true, "last_delivery_status": "success"}},
+				{"id": "alert_2", "webhook": {"configured": false}}
+			],
+			"pagination": {"page": 1, "page_size": 20, "total_items": 2, "total_pages": 1}
+		}`))
+	})
+	defer server.Close()
+
+	page, err := client.ListAlertsPage(context.Background(), &ListAlertsOptions{IncludeWebhook: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if page.Items[0].Webhook == nil || page.Items[0].Webhook.LastDeliveryStatus != "success" {
+		t.Errorf("expected webhook summary on first alert, got %+v", page.Items[0].Webhook)
+	}
+	if page.Items[1].Webhook == nil || page.Items[1].Webhook.Configured {
+		t.Errorf("expected unconfigured webhook on second alert, got %+v", page.Items[1].Webhook)
+	}
+}
+
+func TestAlertWebhookSummary_MarshalOmitsZeroDelivery(t *testing.T) {
+	data, err := json.Marshal(AlertWebhookSummary{Configured: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(data, []byte("last_delivery_at")) {
+		t.Errorf("expected zero last_delivery_at to be omitted, got %s", data)
+	}
+}
+
+func TestListAlertsWithOptions(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("expected no query parameters, got %s", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(ListAlertsResponse{Alerts: []Alert{{ID: "alert_1"}}})
+	})
+	defer server.Close()
+
+	resp, err := client.ListAlertsWithOptions(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Alerts) != 1 {
+		t.Errorf("expected 1 alert, got %d", len(resp.Alerts))
+	}
+}
+
+func TestGetAlertNotificationsWithOptions(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") != "3" {
+			t.Errorf("expected page=3, got %s", r.URL.Query().Get("page"))
+		}
+		json.NewEncoder(w).Encode(ListNotificationsResponse{Notifications: []Notification{{ID: "notif_1"}}})
+	})
+	defer server.Close()
+
+	resp, err := client.GetAlertNotificationsWithOptions(context.Background(), "alert_123", &ListNotificationsOptions{Page: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Notifications) != 1 {
+		t.Errorf("expected 1 notification, got %d", len(resp.Notifications))
+	}
+}
+
+func TestListAlertsWithOptions_Sort(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("sort_by") != "updated_at" || q.Get("sort_order") != "asc" {
+			t.Errorf("unexpected sort parameters %s", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(ListAlertsResponse{})
+	})
+	defer server.Close()
+
+	_, err := client.ListAlertsWithOptions(context.Background(), &ListAlertsOptions{SortBy: AlertSortByUpdatedAt, SortOrder: SortAsc})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestListAlertsWithOptions_Filters(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		expected := map[string]string{
+			"is_active":      "false",
+			"name_contains":  "brand",
+			"phrase":         "acme",
+			"created_after":  "2024-01-01T00:00:00Z",
+			"created_before": "2024-02-01T00:00:00Z",
+		}
+		for key, want := range expected {
+			if got := q.Get(key); got != want {
+				t.Errorf("expected %s=%s, got %s", key, want, got)
+			}
+		}
+		json.NewEncoder(w).Encode(ListAlertsResponse{})
+	})
+	defer server.Close()
+
+	isActive := false
+	_, err := client.ListAlertsWithOptions(context.Background(), &ListAlertsOptions{
+		IsActive:      &isActive,
+		NameContains:  "brand",
+		Phrase:        "acme",
+		CreatedAfter:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		CreatedBefore: time.Date(2024, 2, 1, 1, 0, 0, 0, time.FixedZone("CET", 3600)),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestAlertTags(t *testing.T) {
+	t.Run("create with tags", func(t *testing.T) {
+		client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			var req CreateAlertRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if len(req.Tags) != 2 || req.Tags[0] != "customer:acme" {
+				t.Errorf("unexpected tags %v", req.Tags)
+			}
+			json.NewEncoder(w).Encode(Alert{ID: "alert_1", Tags: req.Tags})
+		})
+		defer server.Close()
+
+		alert, err := client.CreateAlert(context.Background(), &CreateAlertRequest{
+			Name:    "Acme",
+			Phrases: []string{"acme"},
+			Tags:    []string{"customer:acme", "project:launch"},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(alert.Tags) != 2 {
+			t.Errorf("expected 2 tags, got %v", alert.Tags)
+		}
+	})
+
+	t.Run("filter by tags", func(t *testing.T) {
+		client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			tags := r.URL.Query()["tag"]
+			if len(tags) != 2 || tags[0] != "customer:acme" || tags[1] != "project:launch" {
+				t.Errorf("unexpected tag filter %v", tags)
+			}
+			json.NewEncoder(w).Encode(ListAlertsResponse{})
+		})
+		defer server.Close()
+
+		_, err := client.ListAlertsWithOptions(context.Background(), &ListAlertsOptions{Tags: []string{"customer:acme", "project:launch"}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestSetAlertActive(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/v2/alerts/alert_123" {
+			t.Errorf("expected path /v2/alerts/alert_123, got %s", r.URL.Path)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if len(body) != 1 || body["is_active"] != false {
+			t.Errorf("expected only is_active=false, got %v", body)
+		}
+
+		json.NewEncoder(w).Encode(Alert{ID: "alert_123", IsActive: false})
+	})
+	defer server.Close()
+
+	alert, err := client.SetAlertActive(context.Background(), "alert_123", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if alert.IsActive {
+		t.Error("expected alert to be inactive")
+	}
+}
+
+func TestCreateAlert_ExcludePhrases(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var req CreateAlertRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if len(req.ExcludePhrases) != 1 || req.ExcludePhrases[0] != "giveaway" {
+			t.Errorf("unexpected exclude phrases %v", req.ExcludePhrases)
+		}
+		json.NewEncoder(w).Encode(Alert{ID: "alert_1", Phrases: req.Phrases, ExcludePhrases: req.ExcludePhrases})
+	})
+	defer server.Close()
+
+	alert, err := client.CreateAlert(context.Background(), &CreateAlertRequest{
+		Name:           "Brand",
+		Phrases:        []string{"acme"},
+		ExcludePhrases: []string{"giveaway"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(alert.ExcludePhrases) != 1 {
+		t.Errorf("expected exclude phrases on alert, got %v", alert.ExcludePhrases)
+	}
+}
+
+func TestUpdateAlert_MatchOptions(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		opts := body["match_options"]
+		if opts["case_sensitive"] != true || opts["whole_word"] != true {
+			t.Errorf("unexpected match options %v", opts)
+		}
+		w.Write([]byte(`{"id":"alert_1","match_options":{"case_sensitive":true,"whole_word":true}}`))
+	})
+	defer server.Close()
+
+	alert, err := client.UpdateAlert(context.Background(), "alert_1", &UpdateAlertRequest{
+		MatchOptions: &MatchOptions{CaseSensitive: true, WholeWord: true},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !alert.MatchOptions.CaseSensitive || !alert.MatchOptions.WholeWord {
+		t.Errorf("unexpected match options %+v", alert.MatchOptions)
+	}
+}
+
+func TestCreateAlert_StreamerScope(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var req CreateAlertRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if len(req.StreamerIDs) != 2 || len(req.ExcludeStreamerIDs) != 0 {
+			t.Errorf("unexpected streamer scope %v / %v", req.StreamerIDs, req.ExcludeStreamerIDs)
+		}
+		json.NewEncoder(w).Encode(Alert{ID: "alert_1", StreamerIDs: req.StreamerIDs})
+	})
+	defer server.Close()
+
+	alert, err := client.CreateAlert(context.Background(), &CreateAlertRequest{
+		Name:        "Roster",
+		Phrases:     []string{"acme"},
+		StreamerIDs: []string{"streamer_1", "streamer_2"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(alert.StreamerIDs) != 2 {
+		t.Errorf("expected 2 streamer IDs, got %v", alert.StreamerIDs)
+	}
+}
+
+func TestCreateAlert_Categories(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var req CreateAlertRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if len(req.Categories) != 1 || req.Categories[0] != "Just Chatting" {
+			t.Errorf("unexpected categories %v", req.Categories)
+		}
+		json.NewEncoder(w).Encode(Alert{ID: "alert_1", Categories: req.Categories})
+	})
+	defer server.Close()
+
+	alert, err := client.CreateAlert(context.Background(), &CreateAlertRequest{
+		Name:       "Chatting",
+		Phrases:    []string{"acme"},
+		Categories: []string{"Just Chatting"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(alert.Categories) != 1 {
+		t.Errorf("expected 1 category, got %v", alert.Categories)
+	}
+}
+
+func TestAlert_ActiveAt(t *testing.T) {
+	businessHours := &Schedule{
+		Days:     Weekdays,
+		Windows:  []ScheduleWindow{{Start: TimeOfDay{Hour: 9}, End: TimeOfDay{Hour: 17}}},
+		Timezone: "UTC",
+	}
+	monday10 := time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)
+	monday20 := time.Date(2024, 3, 4, 20, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		alert Alert
+		at    time.Time
+		want  bool
+	}{
+		{"active without schedule", Alert{IsActive: true}, monday20, true},
+		{"inactive", Alert{IsActive: false, Schedule: businessHours}, monday10, false},
+		{"within schedule", Alert{IsActive: true, Schedule: businessHours}, monday10, true},
+		{"outside schedule", Alert{IsActive: true, Schedule: businessHours}, monday20, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.alert.ActiveAt(tt.at)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCreateAlert_Schedule(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Schedule json.RawMessage `json:"schedule"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		expected := `{"days":["mon","tue","wed","thu","fri"],"windows":[{"start":"09:00","end":"17:00"}],"timezone":"America/New_York"}`
+		if string(body.Schedule) != expected {
+			t.Errorf("expected schedule %s, got %s", expected, body.Schedule)
+		}
+		w.Write([]byte(`{"id":"alert_1","schedule":` + expected + `}`))
+	})
+	defer server.Close()
+
+	alert, err := client.CreateAlert(context.Background(), &CreateAlertRequest{
+		Name:    "On call",
+		Phrases: []string{"acme"},
+		Schedule: &Schedule{
+			Days:     Weekdays,
+			Windows:  []ScheduleWindow{{Start: TimeOfDay{Hour: 9}, End: TimeOfDay{Hour: 17}}},
+			Timezone: "America/New_York",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if alert.Schedule == nil || alert.Schedule.Timezone != "America/New_York" {
+		t.Errorf("unexpected schedule %+v", alert.Schedule)
+	}
+}
+
+func TestTestAlert(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/v2/alerts/alert_123/test" {
+			t.Errorf("expected path /v2/alerts/alert_123/test, got %s", r.URL.Path)
+		}
+
+		var req TestAlertRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.Text != "I love Acme rockets" || req.StreamID != "" {
+			t.Errorf("unexpected request: %+v", req)
+		}
+
+		json.NewEncoder(w).Encode(TestAlertResponse{
+			Matches: []AlertMatch{{Phrase: "acme", MatchedText: "Acme", Offset: 7, Context: "I love Acme rockets"}},
+		})
+	})
+	defer server.Close()
+
+	result, err := client.TestAlert(context.Background(), "alert_123", "I love Acme rockets")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Matches) != 1 || result.Matches[0].Offset != 7 {
+		t.Errorf("unexpected matches: %+v", result.Matches)
+	}
+}
+
+func TestTestAlertAgainstStream(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var req TestAlertRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.StreamID != "stream_1" || req.Text != "" {
+			t.Errorf("unexpected request: %+v", req)
+		}
+
+		json.NewEncoder(w).Encode(TestAlertResponse{
+			Matches:  []AlertMatch{{Phrase: "acme", Start: 61.5, End: 62}},
+			Excluded: []AlertMatch{{Phrase: "acme", Context: "acme anvils"}},
+		})
+	})
+	defer server.Close()
+
+	result, err := client.TestAlertAgainstStream(context.Background(), "alert_123", "stream_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Matches) != 1 || result.Matches[0].Start != 61.5 {
+		t.Errorf("unexpected matches: %+v", result.Matches)
+	}
+	if len(result.Excluded) != 1 {
+		t.Errorf("expected 1 excluded match, got %d", len(result.Excluded))
+	}
+}
+
+func TestTestAlert_EmptyText(t *testing.T) {
+	client, _ := NewClient("test-token")
+	if _, err := client.TestAlert(context.Background(), "alert_123", "  "); err == nil {
+		t.Error("expected error for empty sample text")
+	}
+}
+
+func TestGetAlertNotificationsWithOptions_Filters(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		expected := map[string]string{
+			"since":          "2024-03-01T22:00:00Z",
+			"until":          "2024-03-02T06:00:00Z",
+			"matched_phrase": "acme",
+			"streamer_id":    "streamer_1",
+			"stream_id":      "stream_9",
+		}
+		for key, want := range expected {
+			if got := q.Get(key); got != want {
+				t.Errorf("expected %s=%s, got %s", key, want, got)
+			}
+		}
+		json.NewEncoder(w).Encode(ListNotificationsResponse{})
+	})
+	defer server.Close()
+
+	_, err := client.GetAlertNotificationsWithOptions(context.Background(), "alert_123", &ListNotificationsOptions{
+		Since:         time.Date(2024, 3, 1, 22, 0, 0, 0, time.UTC),
+		Until:         time.Date(2024, 3, 2, 6, 0, 0, 0, time.UTC),
+		MatchedPhrase: "acme",
+		StreamerID:    "streamer_1",
+		StreamID:      "stream_9",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestAckNotification(t *testing.T) {
+	readAt := time.Date(2024, 3, 2, 9, 0, 0, 0, time.UTC)
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/v2/notifications/notif_1/ack" {
+			t.Errorf("expected path /v2/notifications/notif_1/ack, got %s", r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(Notification{ID: "notif_1", Acknowledged: true, ReadAt: &readAt})
+	})
+	defer server.Close()
+
+	n, err := client.AckNotification(context.Background(), "notif_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !n.Acknowledged || n.ReadAt == nil || !n.ReadAt.Equal(readAt) {
+		t.Errorf("unexpected notification: %+v", n)
+	}
+}
+
+func TestGetAlertNotificationsWithOptions_Unread(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("unread"); got != "true" {
+			t.Errorf("expected unread=true, got %q", got)
+		}
+		json.NewEncoder(w).Encode(ListNotificationsResponse{})
+	})
+	defer server.Close()
+
+	_, err := client.GetAlertNotificationsWithOptions(context.Background(), "alert_123", &ListNotificationsOptions{Unread: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestGetAlertHistory(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/alerts/alert_123/history" {
+			t.Errorf("expected path /v2/alerts/alert_123/history, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"changes":[{
+			"id": "chg_1",
+			"alert_id": "alert_123",
+			"action": "updated",
+			"actor": "ops@example.com",
+			"changed_at": "2024-03-01T12:00:00Z",
+			"fields": [{"field": "phrases", "old_value": ["acme"], "new_value": ["acme corp"]}]
+		}]}`))
+	})
+	defer server.Close()
+
+	history, err := client.GetAlertHistory(context.Background(), "alert_123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(history.Changes) != 1 || history.Changes[0].Actor != "ops@example.com" {
+		t.Fatalf("unexpected history %+v", history)
+	}
+	field := history.Changes[0].Fields[0]
+	var newValue []string
+	if err := json.Unmarshal(field.NewValue, &newValue); err != nil || newValue[0] != "acme corp" {
+		t.Errorf("unexpected new value %s", field.NewValue)
+	}
+}
+
+func TestArchiveAlert(t *testing.T) {
+	archivedAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/v2/alerts/alert_123/archive" {
+			t.Errorf("expected path /v2/alerts/alert_123/archive, got %s", r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(Alert{ID: "alert_123", IsActive: true, ArchivedAt: &archivedAt})
+	})
+	defer server.Close()
+
+	alert, err := client.ArchiveAlert(context.Background(), "alert_123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if alert.ArchivedAt == nil || !alert.ArchivedAt.Equal(archivedAt) {
+		t.Errorf("expected archived_at %v, got %v", archivedAt, alert.ArchivedAt)
+	}
+	if active, _ := alert.ActiveAt(time.Now()); active {
+		t.Error("expected archived alert to be inactive")
+	}
+}
+
+func TestListAlertsWithOptions_Archived(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("archived"); got != "true" {
+			t.Errorf("expected archived=true, got %q", got)
+		}
+		json.NewEncoder(w).Encode(ListAlertsResponse{})
+	})
+	defer server.Close()
+
+	archived := true
+	if _, err := client.ListAlertsWithOptions(context.Background(), &ListAlertsOptions{Archived: &archived}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
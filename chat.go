@@ -0,0 +1,71 @@
+package corestream
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// GetStreamChat returns a page of a stream's chat log, oldest first.
+// opts may be nil.
+func (c *Client) GetStreamChat(ctx context.Context, streamID string, opts *StreamChatOptions) (*StreamChatResponse, error) {
+	if opts == nil {
+		opts = &StreamChatOptions{}
+	}
+	return c.getStreamChat(ctx, streamID, opts)
+}
+
+// GetStreamChatPage returns a single page of a stream's chat log that can
+// fetch the pages after it. opts may be nil.
+func (c *Client) GetStreamChatPage(ctx context.Context, streamID string, opts *StreamChatOptions) (*Page[ChatMessage], error) {
+	startPage, fetch := c.chatFetcher(streamID, opts)
+	return fetchPage(ctx, startPage, fetch)
+}
+
+// StreamChatIterator returns an iterator over a stream's chat log, fetching
+// pages as needed. opts may be nil.
+func (c *Client) StreamChatIterator(ctx context.Context, streamID string, opts *StreamChatOptions, popts ...PaginationOption) iter.Seq2[ChatMessage, error] {
+	startPage, fetch := c.chatFetcher(streamID, opts)
+	return paginate(ctx, startPage, fetch, popts...)
+}
+
+func (c *Client) chatFetcher(streamID string, opts *StreamChatOptions) (int, pageFetcher[ChatMessage]) {
+	base := StreamChatOptions{}
+	if opts != nil {
+		base = *opts
+	}
+	return base.Page, func(ctx context.Context, page int) ([]ChatMessage, Pagination, error) {
+		o := base
+		o.Page = page
+		resp, err := c.getStreamChat(ctx, streamID, &o)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+		return resp.Messages, resp.Pagination, nil
+	}
+}
+
+func (c *Client) getStreamChat(ctx context.Context, streamID string, opts *StreamChatOptions) (*StreamChatResponse, error) {
+	if opts.To != 0 && opts.To <= opts.From {
+		return nil, fmt.Errorf("corestream: chat range ends before it starts")
+	}
+	query := url.Values{}
+	if opts.Page > 0 {
+		query.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.PageSize > 0 {
+		query.Set("page_size", strconv.Itoa(opts.PageSize))
+	}
+	setOffset(query, "from", opts.From)
+	setOffset(query, "to", opts.To)
+
+	path := fmt.Sprintf("/v2/streams/%s/chat", streamID)
+	var resp StreamChatResponse
+	if err := c.request(ctx, http.MethodGet, path, query, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,106 @@
+package corestream
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestGetStreamChat(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/streams/stream_1/chat" {
+			t.Errorf("expected path /v2/streams/stream_1/chat, got %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("from") != "60" || q.Get("to") != "180" || q.Get("page_size") != "50" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"messages":[
+			{"id":"m1","user_id":"u1","user_login":"viewer","text":"acme gang","offset":61.5,"timestamp":"2024-03-01T20:01:01Z"}
+		],"pagination":{"page":1,"page_size":50,"total_items":1,"total_pages":1}}`))
+	})
+	defer server.Close()
+
+	resp, err := client.GetStreamChat(context.Background(), "stream_1", &StreamChatOptions{
+		PageSize: 50,
+		From:     time.Minute,
+		To:       3 * time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Messages) != 1 {
+		t.Fatalf("expected 1 message, got %d", len(resp.Messages))
+	}
+	if m := resp.Messages[0]; m.Text != "acme gang" || m.Offset != 61.5 || m.UserLogin != "viewer" {
+		t.Errorf("unexpected message: %+v", m)
+	}
+}
+
+func TestGetStreamChat_InvalidRange(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request should be made for an invalid range")
+	})
+	defer server.Close()
+
+	_, err := client.GetStreamChat(context.Background(), "stream_1", &StreamChatOptions{From: time.Hour, To: time.Minute})
+	if err == nil {
+		t.Error("expected an error for an invalid range")
+	}
+}
+
+func TestStreamChatIterator(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		json.NewEncoder(w).Encode(StreamChatResponse{
+			Messages:   []ChatMessage{{ID: "m" + strconv.Itoa(page)}},
+			Pagination: Pagination{Page: page, PageSize: 1, TotalItems: 3, TotalPages: 3},
+		})
+	})
+	defer server.Close()
+
+	var ids []string
+	for m, err := range client.StreamChatIterator(context.Background(), "stream_1", nil) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ids = append(ids, m.ID)
+	}
+	if len(ids) != 3 || ids[0] != "m1" || ids[2] != "m3" {
+		t.Errorf("expected messages m1..m3, got %v", ids)
+	}
+}
+
+func TestGetStreamChatPage(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		json.NewEncoder(w).Encode(StreamChatResponse{
+			Messages:   []ChatMessage{{ID: "m" + strconv.Itoa(page)}},
+			Pagination: Pagination{Page: page, PageSize: 1, TotalItems: 2, TotalPages: 2},
+		})
+	})
+	defer server.Close()
+
+	ctx := context.Background()
+	page, err := client.GetStreamChatPage(ctx, "stream_1", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].ID != "m1" {
+		t.Errorf("unexpected first page %+v", page.Items)
+	}
+
+	next, err := page.NextPage(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(next.Items) != 1 || next.Items[0].ID != "m2" {
+		t.Errorf("unexpected second page %+v", next.Items)
+	}
+	if next.HasNext() {
+		t.Error("expected no page after the last")
+	}
+}
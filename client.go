@@ -8,7 +8,11 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"net/http/httptrace"
 	"net/url"
+	"strconv"
+	"sync"
+	"time"
 )
 
 const (
@@ -21,6 +25,15 @@ type Client struct {
 	baseURL    *url.URL
 	token      string
 	httpClient HTTPClient
+
+	slowCallThreshold time.Duration
+	slowCallFunc      SlowCallFunc
+	dryRun            bool
+	maxResponseSize   int64
+	searchCache       *searchCache
+	searchAutoCorrect bool
+	signingKeys       signingKeyCache
+	inflight          *inflightGroup
 }
 
 // Option is a functional option for configuring the client.
@@ -73,8 +86,48 @@ func WithHTTPClient(httpClient HTTPClient) Option {
 	}
 }
 
+// WithDryRun prevents mutating requests (POST, PUT, PATCH, DELETE) from being
+// sent. Instead, they fail with a *DryRunError describing the request that
+// would have been made. Read-only requests are sent as usual.
+func WithDryRun() Option {
+	return func(c *Client) error {
+		c.dryRun = true
+		return nil
+	}
+}
+
+// WithMaxResponseSize limits how many bytes of a response body the client will
+// read. Responses exceeding the limit fail with ErrResponseTooLarge.
+// By default, response size is unlimited.
+func WithMaxResponseSize(bytes int64) Option {
+	return func(c *Client) error {
+		if bytes <= 0 {
+			return fmt.Errorf("corestream: max response size must be positive")
+		}
+		c.maxResponseSize = bytes
+		return nil
+	}
+}
+
+// WithSearchAutoCorrect makes stream searches that find nothing but come back
+// with spelling suggestions retry once with the best suggestion. The retried
+// response has CorrectedQuery set.
+func WithSearchAutoCorrect() Option {
+	return func(c *Client) error {
+		c.searchAutoCorrect = true
+		return nil
+	}
+}
+
 // request performs an HTTP request to the API.
-func (c *Client) request(ctx context.Context, method, path string, query url.Values, body, result interface{}) error {
+func (c *Client) request(ctx context.Context, method, path string, query url.Values, body, result interface{}) (err error) {
+	var tracer *callTracer
+	if c.slowCallFunc != nil {
+		tracer = newCallTracer()
+		ctx = httptrace.WithClientTrace(ctx, tracer.clientTrace())
+		defer func() { c.observe(method, path, tracer, err) }()
+	}
+
 	u, err := c.baseURL.Parse(path)
 	if err != nil {
 		return fmt.Errorf("corestream: invalid path %q: %w", path, err)
@@ -86,18 +139,141 @@ func (c *Client) request(ctx context.Context, method, path string, query url.Val
 		u.RawQuery = query.Encode()
 	}
 
+	var jsonBody []byte
 	var bodyReader io.Reader
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return fmt.Errorf("corestream: failed to encode request body: %w", err)
 		}
 		bodyReader = bytes.NewReader(jsonBody)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, u.String(), bodyReader)
+	if c.dryRun && isMutating(method) {
+		return &DryRunError{Method: method, URL: u.String(), Body: jsonBody}
+	}
+
+	var resp *rawResponse
+	if c.inflight != nil && method == http.MethodGet {
+		resp, err = c.inflight.do(ctx, u.String(), func(ctx context.Context) (*rawResponse, error) {
+			resp, err := c.send(ctx, method, u.String(), bodyReader, tracer)
+			if err != nil {
+				return nil, err
+			}
+			// The body is shared between callers, so it can't live in a pooled buffer.
+			shared := &rawResponse{statusCode: resp.statusCode, body: bytes.Clone(resp.body)}
+			resp.release()
+			return shared, nil
+		})
+	} else {
+		resp, err = c.send(ctx, method, u.String(), bodyReader, tracer)
+	}
+	if err != nil {
+		return err
+	}
+	defer resp.release()
+
+	if resp.statusCode >= 400 {
+		return newAPIError(resp.statusCode, resp.body)
+	}
+
+	if result != nil && len(resp.body) > 0 {
+		if err := decodeJSON(resp.body, result); err != nil {
+			return fmt.Errorf("corestream: failed to decode response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// openStream performs a request whose response body the caller reads
+// incrementally, with the same tracing, dry-run, and size limit handling as
+// request. On success the caller must call done with the outcome of reading
+// body, which closes the response.
+func (c *Client) openStream(ctx context.Context, method, path string, query url.Values) (body io.Reader, done func(error), err error) {
+	var tracer *callTracer
+	if c.slowCallFunc != nil {
+		tracer = newCallTracer()
+		ctx = httptrace.WithClientTrace(ctx, tracer.clientTrace())
+	}
+	observe := func(err error) {
+		if tracer != nil {
+			c.observe(method, path, tracer, err)
+		}
+	}
+
+	u, err := c.baseURL.Parse(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("corestream: invalid path %q: %w", path, err)
+	}
+	if len(query) > 0 {
+		u.RawQuery = query.Encode()
+	}
+	if c.dryRun && isMutating(method) {
+		return nil, nil, &DryRunError{Method: method, URL: u.String()}
+	}
+
+	resp, err := c.do(ctx, method, u.String(), nil)
 	if err != nil {
-		return fmt.Errorf("corestream: failed to create request: %w", err)
+		observe(err)
+		return nil, nil, err
+	}
+	if tracer != nil {
+		tracer.setStatusCode(resp.StatusCode)
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+		apiErr := newAPIError(resp.StatusCode, raw)
+		observe(apiErr)
+		return nil, nil, apiErr
+	}
+
+	body = resp.Body
+	if c.maxResponseSize > 0 {
+		body = &limitedReader{r: resp.Body, limit: c.maxResponseSize}
+	}
+	return body, func(err error) {
+		resp.Body.Close()
+		observe(err)
+	}, nil
+}
+
+// bufferPool holds buffers for reading response bodies, which avoids
+// repeatedly growing large buffers for multi-megabyte transcripts.
+var bufferPool = sync.Pool{
+	New: func() any { return new(bytes.Buffer) },
+}
+
+// maxPooledBufferSize keeps unusually large buffers out of the pool.
+const maxPooledBufferSize = 16 << 20
+
+// rawResponse is a fully read API response.
+type rawResponse struct {
+	statusCode int
+	body       []byte
+	buf        *bytes.Buffer
+}
+
+// release returns the response buffer to the pool. The body must not be
+// used afterwards.
+func (r *rawResponse) release() {
+	if r.buf == nil {
+		return
+	}
+	if r.buf.Cap() <= maxPooledBufferSize {
+		r.buf.Reset()
+		bufferPool.Put(r.buf)
+	}
+	r.buf = nil
+	r.body = nil
+}
+
+// do sends an authenticated API request and returns the unread response.
+func (c *Client) do(ctx context.Context, method, rawURL string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, body)
+	if err != nil {
+		return nil, fmt.Errorf("corestream: failed to create request: %w", err)
 	}
 
 	req.Header.Set("Authorization", "Bearer "+c.token)
@@ -109,37 +285,88 @@ func (c *Client) request(ctx context.Context, method, path string, query url.Val
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("corestream: request failed: %w", err)
+		return nil, fmt.Errorf("corestream: request failed: %w", err)
 	}
-	defer resp.Body.Close()
+	return resp, nil
+}
 
-	respBody, err := io.ReadAll(resp.Body)
+// send performs the HTTP round trip and reads the response body.
+func (c *Client) send(ctx context.Context, method, rawURL string, body io.Reader, tracer *callTracer) (*rawResponse, error) {
+	resp, err := c.do(ctx, method, rawURL, body)
 	if err != nil {
-		return fmt.Errorf("corestream: failed to read response: %w", err)
+		return nil, err
 	}
+	defer resp.Body.Close()
 
-	if resp.StatusCode >= 400 {
-		apiErr := &APIError{StatusCode: resp.StatusCode}
-		if len(respBody) > 0 {
-			var errResp struct {
-				Error struct {
-					Code    string `json:"code"`
-					Message string `json:"message"`
-				} `json:"error"`
-			}
-			if json.Unmarshal(respBody, &errResp) == nil {
-				apiErr.Code = errResp.Error.Code
-				apiErr.Message = errResp.Error.Message
-			}
-		}
-		return apiErr
+	if tracer != nil {
+		tracer.setStatusCode(resp.StatusCode)
 	}
 
-	if result != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, result); err != nil {
-			return fmt.Errorf("corestream: failed to decode response: %w", err)
+	var respReader io.Reader = resp.Body
+	if c.maxResponseSize > 0 {
+		respReader = io.LimitReader(resp.Body, c.maxResponseSize+1)
+	}
+	buf := bufferPool.Get().(*bytes.Buffer)
+	raw := &rawResponse{statusCode: resp.StatusCode, buf: buf}
+	if _, err := buf.ReadFrom(respReader); err != nil {
+		raw.release()
+		return nil, fmt.Errorf("corestream: failed to read response: %w", err)
+	}
+	if c.maxResponseSize > 0 && int64(buf.Len()) > c.maxResponseSize {
+		raw.release()
+		return nil, fmt.Errorf("%w: limit is %d bytes", ErrResponseTooLarge, c.maxResponseSize)
+	}
+	raw.body = buf.Bytes()
+
+	return raw, nil
+}
+
+// newAPIError builds an APIError from an error response body.
+func newAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{StatusCode: statusCode}
+	if len(body) > 0 {
+		var errResp struct {
+			Error struct {
+				Code    string `json:"code"`
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if json.Unmarshal(body, &errResp) == nil {
+			apiErr.Code = errResp.Error.Code
+			apiErr.Message = errResp.Error.Message
 		}
 	}
+	return apiErr
+}
 
-	return nil
+// setSort adds sort parameters to a list query.
+func setSort(query url.Values, sortBy string, order SortOrder) {
+	if sortBy != "" {
+		query.Set("sort_by", sortBy)
+	}
+	if order != "" {
+		query.Set("sort_order", string(order))
+	}
+}
+
+// setTime adds a time parameter in RFC 3339 format, skipping zero times.
+func setTime(query url.Values, key string, t time.Time) {
+	if !t.IsZero() {
+		query.Set(key, t.UTC().Format(time.RFC3339))
+	}
+}
+
+// setOffset sets a stream offset parameter in seconds, skipping zero values.
+func setOffset(query url.Values, key string, d time.Duration) {
+	if d > 0 {
+		query.Set(key, strconv.FormatFloat(d.Seconds(), 'f', -1, 64))
+	}
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
 }
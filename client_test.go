@@ -2,13 +2,14 @@ package corestream
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 )
 
 // setupTestServer creates a mock server and client for testing.
-func setupTestServer(t *testing.T, handler http.HandlerFunc) (*Client, *httptest.Server) {
+func setupTestServer(t testing.TB, handler http.HandlerFunc) (*Client, *httptest.Server) {
 	t.Helper()
 	server := httptest.NewServer(handler)
 	client, err := NewClient("test-token", WithBaseURL(server.URL))
@@ -190,3 +191,93 @@ func TestClient_ErrorResponse_WithDetails(t *testing.T) {
 		t.Errorf("expected message 'Invalid parameters', got %q", apiErr.Message)
 	}
 }
+
+func TestClient_DryRun(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"id":"alert_123"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("test-token", WithBaseURL(server.URL), WithDryRun())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	t.Run("mutating request is not sent", func(t *testing.T) {
+		_, err := client.CreateAlert(ctx, &CreateAlertRequest{
+			Name:    "My Alert",
+			Phrases: []string{"phrase1"},
+		})
+		if !IsDryRun(err) {
+			t.Fatalf("expected dry run error, got %v", err)
+		}
+
+		var dryRunErr *DryRunError
+		if !errors.As(err, &dryRunErr) {
+			t.Fatalf("expected DryRunError, got %T", err)
+		}
+		if dryRunErr.Method != http.MethodPost {
+			t.Errorf("expected method POST, got %s", dryRunErr.Method)
+		}
+		if dryRunErr.URL != server.URL+"/v2/alerts" {
+			t.Errorf("expected URL %s/v2/alerts, got %s", server.URL, dryRunErr.URL)
+		}
+		if string(dryRunErr.Body) != `{"name":"My Alert","phrases":["phrase1"]}` {
+			t.Errorf("unexpected body %s", dryRunErr.Body)
+		}
+
+		if err := client.DeleteAlert(ctx, "alert_123"); !IsDryRun(err) {
+			t.Errorf("expected dry run error for delete, got %v", err)
+		}
+		if requests != 0 {
+			t.Errorf("expected no requests to be sent, got %d", requests)
+		}
+	})
+
+	t.Run("read-only request is sent", func(t *testing.T) {
+		if _, err := client.GetAlert(ctx, "alert_123"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if requests != 1 {
+			t.Errorf("expected 1 request to be sent, got %d", requests)
+		}
+	})
+}
+
+func TestClient_MaxResponseSize(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"streamer_123","login":"teststreamer"}`))
+	}))
+	defer server.Close()
+
+	t.Run("within limit", func(t *testing.T) {
+		client, err := NewClient("test-token", WithBaseURL(server.URL), WithMaxResponseSize(1024))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.GetStreamer(context.Background(), "streamer_123"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("exceeds limit", func(t *testing.T) {
+		client, err := NewClient("test-token", WithBaseURL(server.URL), WithMaxResponseSize(10))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = client.GetStreamer(context.Background(), "streamer_123")
+		if !errors.Is(err, ErrResponseTooLarge) {
+			t.Fatalf("expected ErrResponseTooLarge, got %v", err)
+		}
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		if _, err := NewClient("test-token", WithMaxResponseSize(0)); err == nil {
+			t.Fatal("expected error for zero limit")
+		}
+	})
+}
@@ -0,0 +1,117 @@
+// Command corestream provides development tools for core.stream integrations.
+//
+// Usage:
+//
+//	corestream webhook replay [flags] [file ...]
+//
+// webhook replay reads captured webhook payloads, one JSON body per line, from
+// the files or standard input and POSTs them to a local receiver with valid
+// signatures. The secret is read from -secret or CORESTREAM_WEBHOOK_SECRET.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/core-stream/api/corestreamtest"
+)
+
+const usage = `usage: corestream webhook replay [flags] [file ...]
+
+Replays captured webhook payloads (JSONL, one raw body per line) to a
+receiver, signed with the given secret. Reads standard input if no files
+are given.
+`
+
+func main() {
+	if len(os.Args) < 3 || os.Args[1] != "webhook" || os.Args[2] != "replay" {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	os.Exit(replay(os.Args[3:]))
+}
+
+func replay(args []string) int {
+	fs := flag.NewFlagSet("webhook replay", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprint(os.Stderr, usage, "\nflags:\n")
+		fs.PrintDefaults()
+	}
+	url := fs.String("url", "http://localhost:8080/webhooks/corestream", "receiver `URL`")
+	secret := fs.String("secret", os.Getenv("CORESTREAM_WEBHOOK_SECRET"), "webhook signing `secret`")
+	keyID := fs.String("key-id", "", "signing key `ID` to send with each delivery")
+	interval := fs.Duration("interval", 0, "pause between deliveries")
+	timeout := fs.Duration("timeout", 10*time.Second, "timeout for each delivery")
+	fs.Parse(args)
+
+	if *secret == "" {
+		fmt.Fprintln(os.Stderr, "corestream: -secret or CORESTREAM_WEBHOOK_SECRET is required")
+		return 2
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	opts := &corestreamtest.ReplayOptions{
+		Client:   &http.Client{Timeout: *timeout},
+		KeyID:    *keyID,
+		Interval: *interval,
+	}
+
+	inputs := fs.Args()
+	if len(inputs) == 0 {
+		inputs = []string{"-"}
+	}
+	failed := false
+	for _, name := range inputs {
+		if !replayFile(ctx, name, *url, *secret, opts) {
+			failed = true
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	if failed {
+		return 1
+	}
+	return 0
+}
+
+// replayFile replays the payloads in the named file, or standard input for
+// "-", and reports whether every delivery succeeded.
+func replayFile(ctx context.Context, name, url, secret string, opts *corestreamtest.ReplayOptions) bool {
+	var r io.Reader = os.Stdin
+	if name != "-" {
+		f, err := os.Open(name)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "corestream: %v\n", err)
+			return false
+		}
+		defer f.Close()
+		r = f
+	}
+
+	results, err := corestreamtest.Replay(ctx, r, url, secret, opts)
+	ok := err == nil
+	for _, res := range results {
+		if res.Err != nil {
+			fmt.Printf("%s:%d: error: %v\n", name, res.Line, res.Err)
+			ok = false
+			continue
+		}
+		fmt.Printf("%s:%d: %d\n", name, res.Line, res.StatusCode)
+		if res.StatusCode >= 300 {
+			ok = false
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "corestream: %s: %v\n", name, err)
+	}
+	return ok
+}
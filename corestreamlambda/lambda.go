@@ -0,0 +1,133 @@
+// Package corestreamlambda receives core.stream webhooks in AWS Lambda
+// functions behind API Gateway (REST or HTTP APIs) or a Lambda function URL.
+//
+// The event types mirror the JSON that Lambda delivers, so the handler can be
+// started without depending on a particular AWS SDK version:
+//
+//	h := corestreamlambda.New(os.Getenv("CORESTREAM_WEBHOOK_SECRET"), handleNotification)
+//	lambda.Start(h.Handle)
+package corestreamlambda
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+
+	corestream "github.com/core-stream/api"
+)
+
+// Request is an API Gateway proxy or Lambda function URL request event.
+// Both the version 1.0 and 2.0 payload formats are supported.
+type Request struct {
+	// HTTPMethod is set by the version 1.0 format.
+	HTTPMethod        string              `json:"httpMethod"`
+	Headers           map[string]string   `json:"headers"`
+	MultiValueHeaders map[string][]string `json:"multiValueHeaders"`
+	Body              string              `json:"body"`
+	IsBase64Encoded   bool                `json:"isBase64Encoded"`
+	RequestContext    RequestContext      `json:"requestContext"`
+}
+
+// RequestContext carries the parts of the request context used by Handler.
+type RequestContext struct {
+	// HTTP is set by the version 2.0 format.
+	HTTP struct {
+		Method   string `json:"method"`
+		SourceIP string `json:"sourceIp"`
+	} `json:"http"`
+	// Identity is set by the version 1.0 format.
+	Identity struct {
+		SourceIP string `json:"sourceIp"`
+	} `json:"identity"`
+}
+
+// Response is an API Gateway proxy or Lambda function URL response.
+type Response struct {
+	StatusCode int               `json:"statusCode"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body"`
+}
+
+// Handler verifies webhook deliveries and dispatches them to a
+// corestream.WebhookReceiver.
+type Handler struct {
+	receiver *corestream.WebhookReceiver
+}
+
+// New creates a Handler that verifies deliveries with secret and passes
+// notifications to handler.
+func New(secret string, handler corestream.WebhookHandler, opts ...corestream.WebhookReceiverOption) *Handler {
+	return NewFromReceiver(corestream.NewWebhookReceiver(secret, handler, opts...))
+}
+
+// NewFromReceiver creates a Handler backed by an existing receiver, so that
+// all of the receiver's options apply.
+func NewFromReceiver(receiver *corestream.WebhookReceiver) *Handler {
+	return &Handler{receiver: receiver}
+}
+
+// Handle processes a single event. It has the signature expected by
+// lambda.Start. Verification and handler failures are reported through the
+// response status code, not the returned error, so that the sender sees them.
+func (h *Handler) Handle(ctx context.Context, req Request) (Response, error) {
+	method := req.RequestContext.HTTP.Method
+	if method == "" {
+		method = req.HTTPMethod
+	}
+	if method != "" && method != http.MethodPost {
+		return textResponse(http.StatusMethodNotAllowed, "method not allowed"), nil
+	}
+
+	body := []byte(req.Body)
+	if req.IsBase64Encoded {
+		decoded, err := base64.StdEncoding.DecodeString(req.Body)
+		if err != nil {
+			return textResponse(http.StatusBadRequest, "invalid body encoding"), nil
+		}
+		body = decoded
+	}
+
+	sourceIP := req.RequestContext.HTTP.SourceIP
+	if sourceIP == "" {
+		sourceIP = req.RequestContext.Identity.SourceIP
+	}
+
+	resp := h.receiver.HandleDelivery(ctx, corestream.Delivery{
+		Header:     req.header(),
+		RemoteAddr: sourceIP,
+		Body:       body,
+	})
+	if resp.StatusCode >= 400 {
+		return textResponse(resp.StatusCode, string(resp.Body)), nil
+	}
+	return Response{
+		StatusCode: resp.StatusCode,
+		Headers:    map[string]string{"Content-Type": "application/json"},
+		Body:       string(resp.Body),
+	}, nil
+}
+
+// header converts the event headers, whose names API Gateway may deliver in
+// any case, to an http.Header.
+func (req *Request) header() http.Header {
+	header := http.Header{}
+	for name, values := range req.MultiValueHeaders {
+		for _, v := range values {
+			header.Add(name, v)
+		}
+	}
+	for name, v := range req.Headers {
+		if header.Get(name) == "" {
+			header.Set(name, v)
+		}
+	}
+	return header
+}
+
+func textResponse(status int, msg string) Response {
+	return Response{
+		StatusCode: status,
+		Headers:    map[string]string{"Content-Type": "text/plain; charset=utf-8"},
+		Body:       msg,
+	}
+}
@@ -0,0 +1,92 @@
+package corestreamlambda
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	corestream "github.com/core-stream/api"
+)
+
+func sign(body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestHandler_Handle(t *testing.T) {
+	secret := "test-secret"
+	body := []byte(`{"id":"notif_1","alert_id":"alert_1"}`)
+
+	var got *corestream.WebhookNotification
+	h := New(secret, func(n *corestream.WebhookNotification) error {
+		got = n
+		return nil
+	})
+
+	t.Run("function URL with base64 body", func(t *testing.T) {
+		got = nil
+		var req Request
+		json.Unmarshal([]byte(`{
+			"headers": {"x-webhook-signature": "`+sign(body, secret)+`", "content-type": "application/json"},
+			"body": "`+base64.StdEncoding.EncodeToString(body)+`",
+			"isBase64Encoded": true,
+			"requestContext": {"http": {"method": "POST", "sourceIp": "203.0.113.7"}}
+		}`), &req)
+
+		resp, err := h.Handle(context.Background(), req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.StatusCode, resp.Body)
+		}
+		if got == nil || got.ID != "notif_1" {
+			t.Errorf("unexpected notification %+v", got)
+		}
+	})
+
+	t.Run("REST API with plain body", func(t *testing.T) {
+		got = nil
+		req := Request{
+			HTTPMethod:        http.MethodPost,
+			MultiValueHeaders: map[string][]string{"X-Webhook-Signature": {sign(body, secret)}},
+			Body:              string(body),
+		}
+		resp, _ := h.Handle(context.Background(), req)
+		if resp.StatusCode != http.StatusOK || got == nil {
+			t.Errorf("expected handled delivery, got status %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		req := Request{
+			HTTPMethod: http.MethodPost,
+			Headers:    map[string]string{"X-Webhook-Signature": sign(body, "wrong-secret")},
+			Body:       string(body),
+		}
+		resp, _ := h.Handle(context.Background(), req)
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("expected status 401, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("wrong method", func(t *testing.T) {
+		resp, _ := h.Handle(context.Background(), Request{HTTPMethod: http.MethodGet})
+		if resp.StatusCode != http.StatusMethodNotAllowed {
+			t.Errorf("expected status 405, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("invalid base64", func(t *testing.T) {
+		resp, _ := h.Handle(context.Background(), Request{HTTPMethod: http.MethodPost, Body: "%%%", IsBase64Encoded: true})
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", resp.StatusCode)
+		}
+	})
+}
@@ -0,0 +1,93 @@
+// Package corestreamtest provides helpers for testing code that receives
+// core.stream webhooks, such as handlers built with corestream.WebhookReceiver:
+//
+//	req := corestreamtest.NewNotificationRequest(t, &corestream.WebhookNotification{
+//		ID:      "notif_1",
+//		AlertID: "alert_1",
+//	}, secret)
+//	rec := httptest.NewRecorder()
+//	receiver.ServeHTTP(rec, req)
+package corestreamtest
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	corestream "github.com/core-stream/api"
+)
+
+// SignPayload returns the signature core.stream sends in the
+// corestream.SignatureHeader for body.
+func SignPayload(body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignPayloadWithTimestamp returns the signature for body delivered with a
+// corestream.TimestampHeader of ts.
+func SignPayloadWithTimestamp(body []byte, ts time.Time, secret string) string {
+	signed := strconv.AppendInt(nil, ts.Unix(), 10)
+	signed = append(signed, '.')
+	return SignPayload(append(signed, body...), secret)
+}
+
+// NewRequest returns a signed webhook POST request carrying body. The request
+// is timestamped with the current time, so it also passes receivers
+// configured with corestream.WithTimestampTolerance.
+func NewRequest(t testing.TB, body []byte, secret string) *http.Request {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+	signRequest(req, body, secret)
+	return req
+}
+
+// signRequest sets the content type, timestamp, and signature headers of a
+// webhook request carrying body.
+func signRequest(req *http.Request, body []byte, secret string) {
+	now := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(corestream.TimestampHeader, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(corestream.SignatureHeader, SignPayloadWithTimestamp(body, now, secret))
+}
+
+// NewNotificationRequest returns a signed webhook request delivering n.
+func NewNotificationRequest(t testing.TB, n *corestream.WebhookNotification, secret string) *http.Request {
+	t.Helper()
+	return NewRequest(t, marshal(t, n), secret)
+}
+
+// NewDigestRequest returns a signed webhook request delivering d. Type is set
+// to corestream.WebhookPayloadTypeDigest if empty.
+func NewDigestRequest(t testing.TB, d *corestream.WebhookNotificationDigest, secret string) *http.Request {
+	t.Helper()
+	if d.Type == "" {
+		cp := *d
+		cp.Type = corestream.WebhookPayloadTypeDigest
+		d = &cp
+	}
+	return NewRequest(t, marshal(t, d), secret)
+}
+
+// NewEventRequest returns a signed webhook request delivering e.
+func NewEventRequest(t testing.TB, e *corestream.WebhookEvent, secret string) *http.Request {
+	t.Helper()
+	return NewRequest(t, marshal(t, e), secret)
+}
+
+func marshal(t testing.TB, v any) []byte {
+	t.Helper()
+	body, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("corestreamtest: encoding payload: %v", err)
+	}
+	return body
+}
@@ -0,0 +1,102 @@
+package corestreamtest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	corestream "github.com/core-stream/api"
+)
+
+func TestSignPayload(t *testing.T) {
+	body := []byte(`{"id":"test"}`)
+	sig := SignPayload(body, "secret")
+	if !corestream.VerifyWebhookSignature(body, sig, "secret") {
+		t.Error("expected signature to verify")
+	}
+	if corestream.VerifyWebhookSignature(body, sig, "other") {
+		t.Error("expected signature not to verify with a different secret")
+	}
+}
+
+func TestSignPayloadWithTimestamp(t *testing.T) {
+	body := []byte(`{"id":"test"}`)
+	ts := time.Unix(1700000000, 0)
+	sig := SignPayloadWithTimestamp(body, ts, "secret")
+	if !corestream.VerifyWebhookSignatureWithTimestamp(body, sig, strconv.FormatInt(ts.Unix(), 10), "secret") {
+		t.Error("expected signature to verify")
+	}
+}
+
+func TestNewNotificationRequest(t *testing.T) {
+	var got *corestream.WebhookNotification
+	receiver := corestream.NewWebhookReceiver("secret", func(n *corestream.WebhookNotification) error {
+		got = n
+		return nil
+	}, corestream.WithTimestampTolerance(time.Minute))
+
+	req := NewNotificationRequest(t, &corestream.WebhookNotification{
+		ID:            "notif_1",
+		AlertID:       "alert_1",
+		MatchedPhrase: "hello",
+	}, "secret")
+	rec := httptest.NewRecorder()
+	receiver.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+	}
+	if got == nil || got.ID != "notif_1" || got.MatchedPhrase != "hello" {
+		t.Errorf("unexpected notification: %+v", got)
+	}
+}
+
+func TestNewDigestRequest(t *testing.T) {
+	var got *corestream.WebhookNotificationDigest
+	receiver := corestream.NewWebhookReceiver("secret", nil,
+		corestream.WithDigestHandler(func(d *corestream.WebhookNotificationDigest) error {
+			got = d
+			return nil
+		}))
+
+	req := NewDigestRequest(t, &corestream.WebhookNotificationDigest{
+		ID:            "digest_1",
+		Notifications: []corestream.WebhookNotification{{ID: "notif_1"}},
+	}, "secret")
+	rec := httptest.NewRecorder()
+	receiver.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+	}
+	if got == nil || got.ID != "digest_1" || len(got.Notifications) != 1 {
+		t.Errorf("unexpected digest: %+v", got)
+	}
+}
+
+func TestNewEventRequest(t *testing.T) {
+	var got *corestream.WebhookEvent
+	receiver := corestream.NewWebhookReceiver("secret", nil)
+	receiver.On(corestream.EventAlertUpdated, func(_ context.Context, e *corestream.WebhookEvent) error {
+		got = e
+		return nil
+	})
+
+	req := NewEventRequest(t, &corestream.WebhookEvent{
+		ID:      "evt_1",
+		Event:   corestream.EventAlertUpdated,
+		AlertID: "alert_1",
+	}, "secret")
+	rec := httptest.NewRecorder()
+	receiver.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+	}
+	if got == nil || got.AlertID != "alert_1" {
+		t.Errorf("unexpected event: %+v", got)
+	}
+}
@@ -0,0 +1,106 @@
+package corestreamtest
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	corestream "github.com/core-stream/api"
+)
+
+// ReplayOptions configures Replay.
+type ReplayOptions struct {
+	// Client sends the requests. Defaults to http.DefaultClient.
+	Client *http.Client
+	// KeyID, if set, is sent in the corestream.SignatureKeyHeader, for
+	// receivers configured with corestream.WithSecrets.
+	KeyID string
+	// Interval is the pause between deliveries.
+	Interval time.Duration
+}
+
+// ReplayResult is the outcome of replaying one payload.
+type ReplayResult struct {
+	// Line is the payload's 1-based line number in the input.
+	Line       int
+	StatusCode int
+	// Err is set if the line is not valid JSON or the request failed.
+	Err error
+}
+
+// Replay reads webhook payloads from r, one raw JSON body per line (JSONL),
+// and POSTs each to url signed with secret and timestamped now, so that
+// captured production deliveries can be reproduced against a local receiver.
+// Blank lines are skipped. Replay stops early only if ctx is done or r can't
+// be read; failures of individual payloads are reported in the results.
+// opts may be nil.
+func Replay(ctx context.Context, r io.Reader, url, secret string, opts *ReplayOptions) ([]ReplayResult, error) {
+	o := ReplayOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Client == nil {
+		o.Client = http.DefaultClient
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, corestream.MaxWebhookBodySize+1)
+
+	var results []ReplayResult
+	line := 0
+	for scanner.Scan() {
+		line++
+		body := bytes.TrimSpace(scanner.Bytes())
+		if len(body) == 0 {
+			continue
+		}
+		if len(results) > 0 && o.Interval > 0 {
+			t := time.NewTimer(o.Interval)
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				return results, ctx.Err()
+			case <-t.C:
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+
+		result := ReplayResult{Line: line}
+		if !json.Valid(body) {
+			result.Err = errors.New("corestreamtest: invalid JSON payload")
+		} else {
+			result.StatusCode, result.Err = replayOne(ctx, o, url, bytes.Clone(body), secret)
+		}
+		results = append(results, result)
+	}
+	if err := scanner.Err(); err != nil {
+		return results, fmt.Errorf("corestreamtest: reading payloads: %w", err)
+	}
+	return results, nil
+}
+
+func replayOne(ctx context.Context, o ReplayOptions, url string, body []byte, secret string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	signRequest(req, body, secret)
+	if o.KeyID != "" {
+		req.Header.Set(corestream.SignatureKeyHeader, o.KeyID)
+	}
+	resp, err := o.Client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	return resp.StatusCode, nil
+}
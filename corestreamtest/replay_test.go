@@ -0,0 +1,72 @@
+package corestreamtest
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	corestream "github.com/core-stream/api"
+)
+
+func TestReplay(t *testing.T) {
+	var got []string
+	receiver := corestream.NewWebhookReceiver("secret", func(n *corestream.WebhookNotification) error {
+		got = append(got, n.ID)
+		return nil
+	}, corestream.WithNotificationValidation())
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	input := strings.Join([]string{
+		`{"id":"n1","alert_id":"a1","timestamp":"2024-01-01T00:00:00Z"}`,
+		``,
+		`{"id":"n2"}`,
+		`not json`,
+		`[{"id":"n3","alert_id":"a1","timestamp":"2024-01-01T00:00:00Z"}]`,
+	}, "\n")
+
+	results, err := Replay(context.Background(), strings.NewReader(input), server.URL, "secret", nil)
+	if err != nil {
+		t.Fatalf("Replay failed: %v", err)
+	}
+	if len(results) != 4 {
+		t.Fatalf("expected 4 results, got %d", len(results))
+	}
+
+	want := []struct {
+		line   int
+		status int
+		err    bool
+	}{
+		{1, 200, false},
+		{3, 400, false},
+		{4, 0, true},
+		{5, 200, false},
+	}
+	for i, w := range want {
+		r := results[i]
+		if r.Line != w.line || r.StatusCode != w.status || (r.Err != nil) != w.err {
+			t.Errorf("result %d: expected line %d status %d error %v, got %+v", i, w.line, w.status, w.err, r)
+		}
+	}
+	if len(got) != 2 || got[0] != "n1" || got[1] != "n3" {
+		t.Errorf("expected n1 and n3 to be handled, got %v", got)
+	}
+}
+
+func TestReplayKeyID(t *testing.T) {
+	receiver := corestream.NewWebhookReceiver("", func(n *corestream.WebhookNotification) error { return nil },
+		corestream.WithSecrets(map[string]string{"k2": "second"}))
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	input := `{"id":"n1"}`
+	results, err := Replay(context.Background(), strings.NewReader(input), server.URL, "second", &ReplayOptions{KeyID: "k2"})
+	if err != nil {
+		t.Fatalf("Replay failed: %v", err)
+	}
+	if len(results) != 1 || results[0].StatusCode != 200 {
+		t.Errorf("expected one 200 result, got %+v", results)
+	}
+}
@@ -19,10 +19,51 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("corestream: request failed with status %d", e.StatusCode)
 }
 
+// ErrResponseTooLarge is returned when a response body exceeds the limit set
+// with WithMaxResponseSize.
+var ErrResponseTooLarge = errors.New("corestream: response body too large")
+
+// ErrDryRun is matched by errors.Is for requests skipped because of WithDryRun.
+var ErrDryRun = errors.New("corestream: dry run")
+
+// DryRunError describes a mutating request that was not sent because the
+// client was created with WithDryRun.
+type DryRunError struct {
+	Method string
+	URL    string
+	Body   []byte
+}
+
+func (e *DryRunError) Error() string {
+	return fmt.Sprintf("corestream: dry run: %s %s", e.Method, e.URL)
+}
+
+// Is reports whether target is ErrDryRun.
+func (e *DryRunError) Is(target error) bool {
+	return target == ErrDryRun
+}
+
+// Asynchronous webhook receiver errors.
+var (
+	ErrWebhookQueueFull      = errors.New("corestream: webhook queue full")
+	ErrWebhookReceiverClosed = errors.New("corestream: webhook receiver is shut down")
+)
+
 // Webhook signature errors.
 var (
-	ErrMissingSignature = errors.New("corestream: missing webhook signature")
-	ErrInvalidSignature = errors.New("corestream: invalid webhook signature")
+	ErrMissingSignature  = errors.New("corestream: missing webhook signature")
+	ErrInvalidSignature  = errors.New("corestream: invalid webhook signature")
+	ErrUnknownSigningKey = errors.New("corestream: unknown webhook signing key")
+	ErrExpiredSigningKey = errors.New("corestream: expired webhook signing key")
+	ErrMissingTimestamp  = errors.New("corestream: missing webhook timestamp")
+	ErrInvalidTimestamp  = errors.New("corestream: webhook timestamp outside tolerance")
+)
+
+// Webhook payload errors.
+var (
+	ErrWebhookBodyTooLarge = errors.New("corestream: webhook body too large")
+	ErrInvalidPayload      = errors.New("corestream: invalid webhook payload")
+	ErrInvalidNotification = errors.New("corestream: invalid webhook notification")
 )
 
 // IsNotFound returns true if the error is a 404 Not Found response.
@@ -45,6 +86,11 @@ func IsForbidden(err error) bool {
 	return isStatusCode(err, 403)
 }
 
+// IsDryRun returns true if the error is a request skipped by WithDryRun.
+func IsDryRun(err error) bool {
+	return errors.Is(err, ErrDryRun)
+}
+
 func isStatusCode(err error, statusCode int) bool {
 	var apiErr *APIError
 	if errors.As(err, &apiErr) {
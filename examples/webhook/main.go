@@ -1,7 +1,9 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"log"
 	"net/http"
 	"os"
@@ -15,8 +17,23 @@ func main() {
 		log.Fatal("CORESTREAM_WEBHOOK_SECRET environment variable is required")
 	}
 
+	// Optionally persist deliveries before acknowledging them, so that
+	// notifications received before a crash are processed after a restart.
+	// This requires a database/sql driver; see store.go.
+	var opts []corestream.WebhookReceiverOption
+	if dsn := os.Getenv("DATABASE_URL"); dsn != "" {
+		store, err := openSQLStore(context.Background(), os.Getenv("DATABASE_DRIVER"), dsn)
+		if err != nil {
+			log.Fatalf("opening notification store: %v", err)
+		}
+		opts = append(opts, corestream.WithStore(store))
+	}
+
 	// Create a webhook receiver with a handler function
-	receiver := corestream.NewWebhookReceiver(secret, handleWebhook)
+	receiver := corestream.NewWebhookReceiver(secret, handleWebhook, opts...)
+	if err := receiver.ProcessPending(context.Background()); err != nil {
+		log.Printf("processing pending notifications: %v", err)
+	}
 
 	// Register the webhook endpoint
 	http.Handle("/webhooks/corestream", receiver)
@@ -66,31 +83,13 @@ func truncate(s string, maxLen int) string {
 func manualWebhookHandler(w http.ResponseWriter, r *http.Request) {
 	secret := os.Getenv("CORESTREAM_WEBHOOK_SECRET")
 
-	// Read the request body
-	body := make([]byte, r.ContentLength)
-	_, err := r.Body.Read(body)
-	if err != nil {
-		http.Error(w, "failed to read body", http.StatusBadRequest)
-		return
-	}
-	defer r.Body.Close()
-
-	// Get the signature from header
-	signature := r.Header.Get(corestream.SignatureHeader)
-	if signature == "" {
-		http.Error(w, "missing signature", http.StatusUnauthorized)
-		return
-	}
-
-	// Verify the signature
-	if !corestream.VerifyWebhookSignature(body, signature, secret) {
+	// Read, verify, and parse the notification
+	notification, err := corestream.VerifyAndParseWebhook(r, secret)
+	switch {
+	case errors.Is(err, corestream.ErrMissingSignature), errors.Is(err, corestream.ErrInvalidSignature):
 		http.Error(w, "invalid signature", http.StatusUnauthorized)
 		return
-	}
-
-	// Parse the notification
-	notification, err := corestream.ParseWebhookNotification(body)
-	if err != nil {
+	case err != nil:
 		http.Error(w, "invalid payload", http.StatusBadRequest)
 		return
 	}
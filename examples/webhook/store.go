@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	corestream "github.com/core-stream/api"
+)
+
+// sqlStore is a corestream.NotificationStore backed by database/sql. The
+// queries work with PostgreSQL and SQLite; register a driver by importing it,
+// for example:
+//
+//	import _ "github.com/jackc/pgx/v5/stdlib" // driver "pgx"
+//	import _ "modernc.org/sqlite"             // driver "sqlite"
+type sqlStore struct {
+	db *sql.DB
+}
+
+const createDeliveriesTable = `
+CREATE TABLE IF NOT EXISTS corestream_deliveries (
+	id           TEXT PRIMARY KEY,
+	received_at  TIMESTAMP NOT NULL,
+	body         TEXT NOT NULL,
+	completed_at TIMESTAMP
+)`
+
+func openSQLStore(ctx context.Context, driver, dsn string) (*sqlStore, error) {
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.ExecContext(ctx, createDeliveriesTable); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &sqlStore{db: db}, nil
+}
+
+func (s *sqlStore) Save(ctx context.Context, d corestream.StoredDelivery) error {
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO corestream_deliveries (id, received_at, body) VALUES ($1, $2, $3)
+		 ON CONFLICT (id) DO NOTHING`,
+		d.ID, d.ReceivedAt.UTC(), string(d.Body))
+	return err
+}
+
+func (s *sqlStore) Complete(ctx context.Context, id string) error {
+	_, err := s.db.ExecContext(ctx,
+		`UPDATE corestream_deliveries SET completed_at = $1 WHERE id = $2`,
+		time.Now().UTC(), id)
+	return err
+}
+
+func (s *sqlStore) Pending(ctx context.Context) ([]corestream.StoredDelivery, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, received_at, body FROM corestream_deliveries
+		 WHERE completed_at IS NULL ORDER BY received_at`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pending []corestream.StoredDelivery
+	for rows.Next() {
+		var d corestream.StoredDelivery
+		var body string
+		if err := rows.Scan(&d.ID, &d.ReceivedAt, &body); err != nil {
+			return nil, err
+		}
+		d.Body = []byte(body)
+		pending = append(pending, d)
+	}
+	return pending, rows.Err()
+}
@@ -0,0 +1,180 @@
+package corestream
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExportFormat is the output format for ExportNotifications.
+type ExportFormat string
+
+// Export formats.
+const (
+	ExportCSV   ExportFormat = "csv"
+	ExportJSONL ExportFormat = "jsonl"
+)
+
+// ExportNotificationsOptions configures ExportNotifications. The embedded
+// list options filter and sort the exported notifications; Page is ignored.
+type ExportNotificationsOptions struct {
+	ListNotificationsOptions
+
+	// Format defaults to ExportCSV.
+	Format ExportFormat
+}
+
+var notificationCSVHeader = []string{
+	"id", "alert_id", "alert_name", "timestamp", "matched_phrase", "context",
+	"stream_id", "streamer_id", "stream_source", "stream_title", "transcript_url",
+	"acknowledged", "read_at",
+}
+
+// ExportNotifications writes every notification for an alert matching opts
+// to w, fetching pages as needed. Notifications are written as they arrive,
+// so a failure part way through leaves a partial export in w. opts may be nil.
+func (c *Client) ExportNotifications(ctx context.Context, alertID string, opts *ExportNotificationsOptions, w io.Writer) error {
+	o := ExportNotificationsOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	o.Page = 0
+
+	var write func(n *Notification) error
+	flush := func() error { return nil }
+	switch o.Format {
+	case "", ExportCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(notificationCSVHeader); err != nil {
+			return err
+		}
+		write = func(n *Notification) error { return cw.Write(notificationCSVRecord(n)) }
+		flush = func() error {
+			cw.Flush()
+			return cw.Error()
+		}
+	case ExportJSONL:
+		enc := json.NewEncoder(w)
+		write = func(n *Notification) error { return enc.Encode(n) }
+	default:
+		return fmt.Errorf("corestream: unsupported export format %q", o.Format)
+	}
+
+	for n, err := range c.NotificationsIterator(ctx, alertID, &o.ListNotificationsOptions) {
+		if err != nil {
+			flush()
+			return err
+		}
+		if err := write(&n); err != nil {
+			return err
+		}
+	}
+	return flush()
+}
+
+func notificationCSVRecord(n *Notification) []string {
+	var readAt string
+	if n.ReadAt != nil {
+		readAt = n.ReadAt.UTC().Format(time.RFC3339)
+	}
+	return []string{
+		n.ID,
+		n.AlertID,
+		n.AlertName,
+		n.Timestamp.UTC().Format(time.RFC3339),
+		n.MatchedPhrase,
+		n.Context,
+		n.StreamID,
+		n.StreamerID,
+		n.StreamSource,
+		n.StreamTitle,
+		n.TranscriptURL,
+		strconv.FormatBool(n.Acknowledged),
+		readAt,
+	}
+}
+
+// ExportSearchResultsOptions configures ExportSearchResults. The embedded
+// search options filter and sort the exported results; Page and Aggregations
+// are ignored.
+type ExportSearchResultsOptions struct {
+	SearchStreamsOptions
+
+	// Format defaults to ExportCSV.
+	Format ExportFormat
+}
+
+var searchResultCSVHeader = []string{
+	"stream_id", "streamer_id", "user_display_name", "title", "created_at",
+	"highlights", "match_starts",
+}
+
+// ExportSearchResults writes every result of a stream search to w, fetching
+// pages as needed. JSONL lines hold results as returned by the API. CSV rows
+// hold highlights as plain text, one per line, with match offsets in seconds
+// separated by semicolons. Like ExportNotifications, a failure part way
+// through leaves a partial export in w. opts may be nil.
+func (c *Client) ExportSearchResults(ctx context.Context, query string, opts *ExportSearchResultsOptions, w io.Writer) error {
+	o := ExportSearchResultsOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	o.Page = 0
+	o.Aggregations = nil
+
+	var write func(r *SearchResult) error
+	flush := func() error { return nil }
+	switch o.Format {
+	case "", ExportCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(searchResultCSVHeader); err != nil {
+			return err
+		}
+		write = func(r *SearchResult) error { return cw.Write(searchResultCSVRecord(r, o.HighlightTag)) }
+		flush = func() error {
+			cw.Flush()
+			return cw.Error()
+		}
+	case ExportJSONL:
+		enc := json.NewEncoder(w)
+		write = func(r *SearchResult) error { return enc.Encode(r) }
+	default:
+		return fmt.Errorf("corestream: unsupported export format %q", o.Format)
+	}
+
+	for r, err := range c.SearchIterator(ctx, query, &o.SearchStreamsOptions) {
+		if err != nil {
+			flush()
+			return err
+		}
+		if err := write(&r); err != nil {
+			return err
+		}
+	}
+	return flush()
+}
+
+func searchResultCSVRecord(r *SearchResult, highlightTag string) []string {
+	highlights := make([]string, len(r.Highlights))
+	for i, h := range r.Highlights {
+		highlights[i] = StripHighlight(h, highlightTag)
+	}
+	starts := make([]string, len(r.Matches))
+	for i, m := range r.Matches {
+		starts[i] = strconv.FormatFloat(m.MatchStart, 'f', -1, 64)
+	}
+	return []string{
+		r.StreamID,
+		r.StreamerID,
+		r.UserDisplayName,
+		r.Title,
+		r.CreatedAt.UTC().Format(time.RFC3339),
+		strings.Join(highlights, "\n"),
+		strings.Join(starts, ";"),
+	}
+}
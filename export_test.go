@@ -0,0 +1,170 @@
+package corestream
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func exportTestServer(t *testing.T) (*Client, func()) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("matched_phrase"); got != "acme" {
+			t.Errorf("expected matched_phrase=acme, got %q", got)
+		}
+		page := r.URL.Query().Get("page")
+		resp := ListNotificationsResponse{
+			Notifications: []Notification{{
+				ID:            "notif_" + page,
+				AlertID:       "alert_123",
+				MatchedPhrase: "acme",
+				Context:       "we love acme, really",
+				Timestamp:     time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+			}},
+			Pagination: Pagination{PageSize: 1, TotalItems: 2, TotalPages: 2},
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+	return client, server.Close
+}
+
+func TestExportNotifications_CSV(t *testing.T) {
+	client, closeServer := exportTestServer(t)
+	defer closeServer()
+
+	var buf bytes.Buffer
+	opts := &ExportNotificationsOptions{ListNotificationsOptions: ListNotificationsOptions{MatchedPhrase: "acme", PageSize: 1}}
+	if err := client.ExportNotifications(context.Background(), "alert_123", opts, &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("failed to read CSV: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("expected header and 2 rows, got %d records", len(records))
+	}
+	if records[0][0] != "id" || records[2][0] != "notif_2" {
+		t.Errorf("unexpected records %v", records)
+	}
+	if records[1][3] != "2024-03-01T12:00:00Z" || records[1][5] != "we love acme, really" {
+		t.Errorf("unexpected row %v", records[1])
+	}
+}
+
+func TestExportNotifications_JSONL(t *testing.T) {
+	client, closeServer := exportTestServer(t)
+	defer closeServer()
+
+	var buf bytes.Buffer
+	opts := &ExportNotificationsOptions{
+		ListNotificationsOptions: ListNotificationsOptions{MatchedPhrase: "acme", PageSize: 1},
+		Format:                   ExportJSONL,
+	}
+	if err := client.ExportNotifications(context.Background(), "alert_123", opts, &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(lines))
+	}
+	var n Notification
+	if err := json.Unmarshal([]byte(lines[1]), &n); err != nil {
+		t.Fatalf("invalid JSON line: %v", err)
+	}
+	if n.ID != "notif_2" {
+		t.Errorf("expected notif_2, got %s", n.ID)
+	}
+}
+
+func TestExportNotifications_UnsupportedFormat(t *testing.T) {
+	client, _ := NewClient("test-token")
+	err := client.ExportNotifications(context.Background(), "alert_123", &ExportNotificationsOptions{Format: "xlsx"}, &bytes.Buffer{})
+	if err == nil {
+		t.Error("expected error for unsupported format")
+	}
+}
+
+func searchExportTestServer(t *testing.T) (*Client, func()) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("q") != "acme" || q.Get("time_range") != TimeRangeWeek {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		if q.Has("aggregation") {
+			t.Errorf("aggregations should not be requested, got %s", r.URL.RawQuery)
+		}
+		page := q.Get("page")
+		resp := SearchStreamsResponse{
+			Results: []SearchResult{{
+				StreamID:   "stream_" + page,
+				StreamerID: "streamer_1",
+				Title:      "Launch day",
+				Highlights: []string{"we <em>love</em> acme", "acme &amp; co"},
+				Matches:    []SearchMatch{{MatchStart: 12.5}, {MatchStart: 90}},
+				CreatedAt:  time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+			}},
+			Pagination: Pagination{PageSize: 1, TotalItems: 2, TotalPages: 2},
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+	return client, server.Close
+}
+
+func TestExportSearchResults_CSV(t *testing.T) {
+	client, closeServer := searchExportTestServer(t)
+	defer closeServer()
+
+	var buf bytes.Buffer
+	opts := &ExportSearchResultsOptions{SearchStreamsOptions: SearchStreamsOptions{
+		TimeRange:    TimeRangeWeek,
+		Aggregations: []SearchAggregation{AggregateByDay},
+	}}
+	if err := client.ExportSearchResults(context.Background(), "acme", opts, &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("expected header and 2 rows, got %d records", len(records))
+	}
+	row := records[1]
+	if row[0] != "stream_1" || row[5] != "we love acme\nacme & co" || row[6] != "12.5;90" {
+		t.Errorf("unexpected row: %q", row)
+	}
+}
+
+func TestExportSearchResults_JSONL(t *testing.T) {
+	client, closeServer := searchExportTestServer(t)
+	defer closeServer()
+
+	var buf bytes.Buffer
+	opts := &ExportSearchResultsOptions{
+		SearchStreamsOptions: SearchStreamsOptions{TimeRange: TimeRangeWeek},
+		Format:               ExportJSONL,
+	}
+	if err := client.ExportSearchResults(context.Background(), "acme", opts, &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(lines))
+	}
+	var r SearchResult
+	if err := json.Unmarshal([]byte(lines[1]), &r); err != nil {
+		t.Fatalf("invalid JSON line: %v", err)
+	}
+	if r.StreamID != "stream_2" || len(r.Matches) != 2 {
+		t.Errorf("unexpected result: %+v", r)
+	}
+}
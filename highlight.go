@@ -0,0 +1,64 @@
+package corestream
+
+import (
+	"html"
+	"strings"
+)
+
+const defaultHighlightTag = "em"
+
+// StripHighlight returns a search highlight as plain text. The <tag> and
+// </tag> markers around matches are removed and HTML entities in the
+// transcript text are unescaped. tag is the SearchStreamsOptions.HighlightTag
+// used for the search and defaults to "em".
+func StripHighlight(highlight, tag string) string {
+	return ReplaceHighlight(highlight, tag, "", "")
+}
+
+// ReplaceHighlight returns a search highlight as plain text with the <tag> and
+// </tag> markers around matches replaced by open and close, for example "**"
+// for Markdown or terminal escape codes. Like StripHighlight it unescapes the
+// transcript text, so the result must be escaped again before it is embedded
+// in HTML. tag defaults to "em".
+func ReplaceHighlight(highlight, tag, open, close string) string {
+	if tag == "" {
+		tag = defaultHighlightTag
+	}
+	startTag, endTag := "<"+tag+">", "</"+tag+">"
+
+	var b strings.Builder
+	for highlight != "" {
+		i := strings.Index(highlight, "<")
+		if i < 0 {
+			b.WriteString(html.UnescapeString(highlight))
+			break
+		}
+		b.WriteString(html.UnescapeString(highlight[:i]))
+		highlight = highlight[i:]
+		switch {
+		case strings.HasPrefix(highlight, startTag):
+			b.WriteString(open)
+			highlight = highlight[len(startTag):]
+		case strings.HasPrefix(highlight, endTag):
+			b.WriteString(close)
+			highlight = highlight[len(endTag):]
+		default:
+			b.WriteByte('<')
+			highlight = highlight[1:]
+		}
+	}
+	return b.String()
+}
+
+// validHighlightTag reports whether tag is a plain element name.
+func validHighlightTag(tag string) bool {
+	for i, r := range tag {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case i > 0 && (r >= '0' && r <= '9' || r == '-'):
+		default:
+			return false
+		}
+	}
+	return tag != ""
+}
@@ -0,0 +1,59 @@
+package corestream
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestStripHighlight(t *testing.T) {
+	tests := []struct {
+		name      string
+		highlight string
+		tag       string
+		want      string
+	}{
+		{"default tag", "we <em>love</em> this game", "", "we love this game"},
+		{"custom tag", "the <mark>new patch</mark> is out", "mark", "the new patch is out"},
+		{"entities", "<em>R&amp;D</em> said &quot;hi&quot; &lt;3", "", `R&D said "hi" <3`},
+		{"other tag kept", "<em>a</em> <b>b</b>", "", "a <b>b</b>"},
+		{"unmatched marker", "a < b <em>c", "", "a < b c"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StripHighlight(tt.highlight, tt.tag); got != tt.want {
+				t.Errorf("StripHighlight(%q) = %q, want %q", tt.highlight, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReplaceHighlight(t *testing.T) {
+	got := ReplaceHighlight("a <em>big</em> &amp; <em>bold</em> claim", "", "**", "**")
+	if want := "a **big** & **bold** claim"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestSearchStreamsWithOptions_Highlight(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("highlight_tag") != "mark" || q.Get("fragment_size") != "120" || q.Get("max_fragments") != "3" {
+			t.Errorf("unexpected highlight parameters %s", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(SearchStreamsResponse{})
+	})
+	defer server.Close()
+
+	opts := &SearchStreamsOptions{HighlightTag: "mark", FragmentSize: 120, MaxFragments: 3}
+	if _, err := client.SearchStreamsWithOptions(context.Background(), "patch", opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, tag := range []string{"<em>", "em onclick=x", "1b"} {
+		if _, err := client.SearchStreamsWithOptions(context.Background(), "patch", &SearchStreamsOptions{HighlightTag: tag}); err == nil {
+			t.Errorf("expected error for highlight tag %q", tag)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package corestream
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// SlowCall describes an API call that took longer than the configured threshold.
+type SlowCall struct {
+	Method     string
+	Endpoint   string
+	StatusCode int
+	Duration   time.Duration
+	Timing     CallTiming
+	Err        error
+}
+
+// CallTiming is a breakdown of where time was spent during an API call.
+// Phases that did not happen (e.g. DNS lookup on a reused connection) are zero.
+type CallTiming struct {
+	DNSLookup       time.Duration
+	Connect         time.Duration
+	TLSHandshake    time.Duration
+	TimeToFirstByte time.Duration
+	ConnReused      bool
+}
+
+// SlowCallFunc is called when an API call exceeds the slow-call threshold.
+type SlowCallFunc func(call SlowCall)
+
+// WithSlowCallThreshold registers a callback that fires for every API call
+// taking longer than d, including calls that failed.
+func WithSlowCallThreshold(d time.Duration, fn SlowCallFunc) Option {
+	return func(c *Client) error {
+		if d <= 0 {
+			return fmt.Errorf("corestream: slow call threshold must be positive")
+		}
+		if fn == nil {
+			return fmt.Errorf("corestream: slow call callback cannot be nil")
+		}
+		c.slowCallThreshold = d
+		c.slowCallFunc = fn
+		return nil
+	}
+}
+
+// callTracer records connection timings via httptrace.
+type callTracer struct {
+	mu         sync.Mutex
+	start      time.Time
+	dnsStart   time.Time
+	connStart  time.Time
+	tlsStart   time.Time
+	timing     CallTiming
+	statusCode int
+}
+
+func newCallTracer() *callTracer {
+	return &callTracer{start: time.Now()}
+}
+
+func (t *callTracer) clientTrace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			t.mu.Lock()
+			t.dnsStart = time.Now()
+			t.mu.Unlock()
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			t.mu.Lock()
+			t.timing.DNSLookup = time.Since(t.dnsStart)
+			t.mu.Unlock()
+		},
+		ConnectStart: func(string, string) {
+			t.mu.Lock()
+			t.connStart = time.Now()
+			t.mu.Unlock()
+		},
+		ConnectDone: func(string, string, error) {
+			t.mu.Lock()
+			t.timing.Connect = time.Since(t.connStart)
+			t.mu.Unlock()
+		},
+		TLSHandshakeStart: func() {
+			t.mu.Lock()
+			t.tlsStart = time.Now()
+			t.mu.Unlock()
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			t.mu.Lock()
+			t.timing.TLSHandshake = time.Since(t.tlsStart)
+			t.mu.Unlock()
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.mu.Lock()
+			t.timing.ConnReused = info.Reused
+			t.mu.Unlock()
+		},
+		GotFirstResponseByte: func() {
+			t.mu.Lock()
+			t.timing.TimeToFirstByte = time.Since(t.start)
+			t.mu.Unlock()
+		},
+	}
+}
+
+func (t *callTracer) setStatusCode(code int) {
+	t.mu.Lock()
+	t.statusCode = code
+	t.mu.Unlock()
+}
+
+// observe reports the call to the slow-call callback if it exceeded the threshold.
+func (c *Client) observe(method, endpoint string, t *callTracer, err error) {
+	elapsed := time.Since(t.start)
+	if elapsed < c.slowCallThreshold {
+		return
+	}
+	t.mu.Lock()
+	call := SlowCall{
+		Method:     method,
+		Endpoint:   endpoint,
+		StatusCode: t.statusCode,
+		Duration:   elapsed,
+		Timing:     t.timing,
+		Err:        err,
+	}
+	t.mu.Unlock()
+	c.slowCallFunc(call)
+}
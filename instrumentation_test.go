@@ -0,0 +1,82 @@
+package corestream
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWithSlowCallThreshold(t *testing.T) {
+	t.Run("fires for slow calls", func(t *testing.T) {
+		var calls []SlowCall
+		client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(20 * time.Millisecond)
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"code":"not_found","message":"Streamer not found"}}`))
+		})
+		defer server.Close()
+
+		if err := WithSlowCallThreshold(10*time.Millisecond, func(call SlowCall) {
+			calls = append(calls, call)
+		})(client); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		_, err := client.GetStreamer(context.Background(), "streamer_123")
+		if !IsNotFound(err) {
+			t.Fatalf("expected not found error, got %v", err)
+		}
+
+		if len(calls) != 1 {
+			t.Fatalf("expected 1 slow call, got %d", len(calls))
+		}
+		call := calls[0]
+		if call.Method != http.MethodGet {
+			t.Errorf("expected method GET, got %s", call.Method)
+		}
+		if call.Endpoint != "/v2/streamers/streamer_123" {
+			t.Errorf("expected endpoint /v2/streamers/streamer_123, got %s", call.Endpoint)
+		}
+		if call.StatusCode != http.StatusNotFound {
+			t.Errorf("expected status 404, got %d", call.StatusCode)
+		}
+		if call.Duration < 20*time.Millisecond {
+			t.Errorf("expected duration >= 20ms, got %s", call.Duration)
+		}
+		if call.Timing.TimeToFirstByte == 0 {
+			t.Error("expected time to first byte to be recorded")
+		}
+		if call.Err == nil {
+			t.Error("expected error to be reported")
+		}
+	})
+
+	t.Run("ignores fast calls", func(t *testing.T) {
+		called := false
+		client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{}`))
+		})
+		defer server.Close()
+
+		WithSlowCallThreshold(time.Minute, func(call SlowCall) {
+			called = true
+		})(client)
+
+		if _, err := client.GetStreamer(context.Background(), "streamer_123"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if called {
+			t.Error("callback should not fire for fast calls")
+		}
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		if _, err := NewClient("token", WithSlowCallThreshold(0, func(SlowCall) {})); err == nil {
+			t.Error("expected error for zero threshold")
+		}
+		if _, err := NewClient("token", WithSlowCallThreshold(time.Second, nil)); err == nil {
+			t.Error("expected error for nil callback")
+		}
+	})
+}
@@ -0,0 +1,13 @@
+//go:build !goexperiment.jsonv2 || !go1.27 || corestream_jsonv1
+
+package corestream
+
+import "encoding/json"
+
+// decodeJSON decodes API response bodies with encoding/json.
+// On Go 1.27+ with the jsonv2 experiment enabled, the faster encoding/json/v2
+// decoder in json_decode_v2.go is used instead unless the corestream_jsonv1
+// build tag is set.
+func decodeJSON(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
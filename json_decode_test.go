@@ -0,0 +1,106 @@
+package corestream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func largeTranscript(segments int) []byte {
+	resp := TranscriptResponse{Segments: make([]TranscriptSegment, segments)}
+	for i := range resp.Segments {
+		resp.Segments[i] = TranscriptSegment{
+			Start: float64(i) * 4.5,
+			End:   float64(i)*4.5 + 4.2,
+			Text:  fmt.Sprintf("segment %d %s", i, strings.Repeat("lorem ipsum ", 8)),
+		}
+	}
+	data, _ := json.Marshal(resp)
+	return data
+}
+
+func largeNotificationList(n int) []byte {
+	resp := ListNotificationsResponse{
+		Notifications: make([]Notification, n),
+		Pagination:    Pagination{Page: 1, PageSize: n, TotalItems: n, TotalPages: 1},
+	}
+	for i := range resp.Notifications {
+		resp.Notifications[i] = Notification{
+			ID:            fmt.Sprintf("notif_%d", i),
+			AlertID:       "alert_123",
+			AlertName:     "Brand Mentions",
+			MatchedPhrase: "our product",
+			Context:       strings.Repeat("context around the match ", 6),
+			StreamSource:  "Twitch",
+			StreamTitle:   "Playing games with chat",
+			Timestamp:     time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+		}
+	}
+	data, _ := json.Marshal(resp)
+	return data
+}
+
+func TestDecodeJSON(t *testing.T) {
+	data := largeTranscript(3)
+	var resp TranscriptResponse
+	if err := decodeJSON(data, &resp); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Segments) != 3 || resp.Segments[2].Start != 9 {
+		t.Errorf("unexpected segments %+v", resp.Segments)
+	}
+
+	// Unknown fields are ignored.
+	var n Notification
+	if err := decodeJSON([]byte(`{"id":"notif_1","new_field":true}`), &n); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n.ID != "notif_1" {
+		t.Errorf("expected ID 'notif_1', got %s", n.ID)
+	}
+}
+
+func BenchmarkDecodeTranscriptResponse(b *testing.B) {
+	data := largeTranscript(20000)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	for b.Loop() {
+		var resp TranscriptResponse
+		if err := decodeJSON(data, &resp); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeListNotificationsResponse(b *testing.B) {
+	data := largeNotificationList(100)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	for b.Loop() {
+		var resp ListNotificationsResponse
+		if err := decodeJSON(data, &resp); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGetStreamTranscript(b *testing.B) {
+	data := largeTranscript(20000)
+	client, server := setupTestServer(b, func(w http.ResponseWriter, r *http.Request) {
+		w.Write(data)
+	})
+	defer server.Close()
+
+	ctx := context.Background()
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := client.GetStreamTranscript(ctx, "stream_123"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+//go:build goexperiment.jsonv2 && go1.27 && !corestream_jsonv1
+
+package corestream
+
+import "encoding/json/v2"
+
+// decodeJSON decodes API response bodies using encoding/json/v2, which is
+// considerably faster on large payloads such as transcripts. Field names in
+// API responses match the struct tags exactly, so the stricter v2 matching
+// rules do not change results. Build with -tags corestream_jsonv1 to fall
+// back to encoding/json.
+func decodeJSON(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
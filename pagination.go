@@ -0,0 +1,219 @@
+package corestream
+
+import (
+	"context"
+	"errors"
+	"iter"
+)
+
+// ErrNoNextPage is returned by Page.NextPage when there are no more pages.
+var ErrNoNextPage = errors.New("corestream: no next page")
+
+// HasNext reports whether there are pages after this one.
+func (p Pagination) HasNext() bool {
+	return p.Page < p.TotalPages
+}
+
+// Page is a single page of a paginated list that knows how to fetch the
+// page that follows it.
+type Page[T any] struct {
+	Items      []T
+	Pagination Pagination
+
+	fetch pageFetcher[T]
+}
+
+// HasNext reports whether there are pages after this one.
+func (p *Page[T]) HasNext() bool {
+	return len(p.Items) > 0 && p.Pagination.HasNext()
+}
+
+// NextPage fetches the page after this one. It returns ErrNoNextPage if
+// this is the last page.
+func (p *Page[T]) NextPage(ctx context.Context) (*Page[T], error) {
+	if !p.HasNext() {
+		return nil, ErrNoNextPage
+	}
+	return fetchPage(ctx, p.Pagination.Page+1, p.fetch)
+}
+
+func fetchPage[T any](ctx context.Context, page int, fetch pageFetcher[T]) (*Page[T], error) {
+	items, pagination, err := fetch(ctx, max(page, 1))
+	if err != nil {
+		return nil, err
+	}
+	if pagination.Page == 0 {
+		pagination.Page = max(page, 1)
+	}
+	return &Page[T]{Items: items, Pagination: pagination, fetch: fetch}, nil
+}
+
+// PaginationOption configures automatic pagination in iterators and ListAll helpers.
+type PaginationOption func(*paginationConfig)
+
+type paginationConfig struct {
+	maxItems int
+	maxPages int
+	prefetch int
+}
+
+// WithMaxItems stops pagination after n items have been returned.
+// Zero or a negative value means no limit.
+func WithMaxItems(n int) PaginationOption {
+	return func(c *paginationConfig) {
+		c.maxItems = n
+	}
+}
+
+// WithMaxPages stops pagination after n pages have been fetched.
+// Zero or a negative value means no limit.
+func WithMaxPages(n int) PaginationOption {
+	return func(c *paginationConfig) {
+		c.maxPages = n
+	}
+}
+
+// WithPrefetch fetches up to n pages ahead in the background while earlier
+// pages are being consumed. Pages are still yielded in order.
+// Zero or a negative value disables prefetching.
+func WithPrefetch(n int) PaginationOption {
+	return func(c *paginationConfig) {
+		c.prefetch = n
+	}
+}
+
+// pageFetcher fetches a single page of a paginated list.
+type pageFetcher[T any] func(ctx context.Context, page int) ([]T, Pagination, error)
+
+// pageResult is the outcome of a prefetched page request.
+type pageResult[T any] struct {
+	items []T
+	err   error
+}
+
+// paginate returns an iterator that walks every page returned by fetch,
+// starting at startPage. Iteration stops at the first error, which is yielded
+// with the zero value of T. If ctx is canceled, iteration stops before the
+// next item and yields ctx.Err().
+func paginate[T any](ctx context.Context, startPage int, fetch pageFetcher[T], opts ...PaginationOption) iter.Seq2[T, error] {
+	var cfg paginationConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return func(yield func(T, error) bool) {
+		var zero T
+		parent := ctx
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		page := max(startPage, 1)
+		// lastPage returns the last page to fetch given the reported total.
+		lastPage := func(totalPages int) int {
+			if cfg.maxPages > 0 {
+				return min(totalPages, page+cfg.maxPages-1)
+			}
+			return totalPages
+		}
+
+		count := 0
+		// emit yields items and reports whether iteration should continue.
+		emit := func(items []T) bool {
+			for _, item := range items {
+				if err := parent.Err(); err != nil {
+					yield(zero, err)
+					return false
+				}
+				if !yield(item, nil) {
+					return false
+				}
+				count++
+				if cfg.maxItems > 0 && count >= cfg.maxItems {
+					return false
+				}
+			}
+			return len(items) > 0
+		}
+
+		if err := ctx.Err(); err != nil {
+			yield(zero, err)
+			return
+		}
+		items, pagination, err := fetch(ctx, page)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		if !emit(items) {
+			return
+		}
+
+		if cfg.prefetch <= 0 {
+			for current := page; current < lastPage(pagination.TotalPages); {
+				current++
+				if err := ctx.Err(); err != nil {
+					yield(zero, err)
+					return
+				}
+				items, pagination, err = fetch(ctx, current)
+				if err != nil {
+					yield(zero, err)
+					return
+				}
+				if !emit(items) {
+					return
+				}
+			}
+			return
+		}
+
+		// Keep up to cfg.prefetch requests in flight, consuming them in order.
+		last := lastPage(pagination.TotalPages)
+		next := page + 1
+		var pending []chan pageResult[T]
+		launch := func() {
+			ch := make(chan pageResult[T], 1)
+			go func(p int) {
+				items, _, err := fetch(ctx, p)
+				ch <- pageResult[T]{items: items, err: err}
+			}(next)
+			pending = append(pending, ch)
+			next++
+		}
+		for next <= last && len(pending) < cfg.prefetch {
+			launch()
+		}
+		for len(pending) > 0 {
+			var result pageResult[T]
+			select {
+			case result = <-pending[0]:
+			case <-ctx.Done():
+				yield(zero, ctx.Err())
+				return
+			}
+			pending = pending[1:]
+			if result.err != nil {
+				yield(zero, result.err)
+				return
+			}
+			if next <= last {
+				launch()
+			}
+			if !emit(result.items) {
+				return
+			}
+		}
+	}
+}
+
+// collect gathers all items from seq into a slice, stopping at the first error.
+func collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var items []T
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
@@ -0,0 +1,226 @@
+package corestream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPagination_HasNext(t *testing.T) {
+	if !(Pagination{Page: 1, TotalPages: 2}).HasNext() {
+		t.Error("expected page 1 of 2 to have a next page")
+	}
+	if (Pagination{Page: 2, TotalPages: 2}).HasNext() {
+		t.Error("expected page 2 of 2 to have no next page")
+	}
+}
+
+func TestPage_NextPage(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if r.URL.Query().Get("streamer_id") != "streamer_123" {
+			t.Errorf("expected filters to be kept across pages")
+		}
+		resp := ListStreamsResponse{
+			Streams:    []Stream{{ID: "stream_" + strconv.Itoa(page)}},
+			Pagination: Pagination{Page: page, PageSize: 1, TotalItems: 2, TotalPages: 2},
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+	defer server.Close()
+
+	ctx := context.Background()
+	page, err := client.ListStreamsPage(ctx, &ListStreamsOptions{PageSize: 1, StreamerID: "streamer_123"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if page.Items[0].ID != "stream_1" {
+		t.Errorf("expected stream_1, got %s", page.Items[0].ID)
+	}
+	if !page.HasNext() {
+		t.Fatal("expected a next page")
+	}
+
+	page, err = page.NextPage(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if page.Items[0].ID != "stream_2" {
+		t.Errorf("expected stream_2, got %s", page.Items[0].ID)
+	}
+	if page.HasNext() {
+		t.Error("expected no next page")
+	}
+
+	if _, err := page.NextPage(ctx); !errors.Is(err, ErrNoNextPage) {
+		t.Errorf("expected ErrNoNextPage, got %v", err)
+	}
+}
+
+func TestPaginate_Prefetch(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		resp := ListAlertsResponse{
+			Alerts:     []Alert{{ID: "alert_" + strconv.Itoa(page)}},
+			Pagination: Pagination{Page: page, PageSize: 1, TotalItems: 6, TotalPages: 6},
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+	defer server.Close()
+
+	var ids []string
+	for alert, err := range client.AlertsIterator(context.Background(), nil, WithPrefetch(3)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ids = append(ids, alert.ID)
+	}
+
+	if len(ids) != 6 {
+		t.Fatalf("expected 6 alerts, got %d", len(ids))
+	}
+	for i, id := range ids {
+		if id != "alert_"+strconv.Itoa(i+1) {
+			t.Errorf("expected alerts in page order, got %v", ids)
+			break
+		}
+	}
+	if maxInFlight.Load() < 2 {
+		t.Errorf("expected concurrent page requests, max in flight was %d", maxInFlight.Load())
+	}
+	if maxInFlight.Load() > 3 {
+		t.Errorf("expected at most 3 requests in flight, got %d", maxInFlight.Load())
+	}
+}
+
+func TestPaginate_PrefetchError(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		resp := ListAlertsResponse{
+			Alerts:     []Alert{{ID: "alert_" + strconv.Itoa(page)}},
+			Pagination: Pagination{Page: page, PageSize: 1, TotalItems: 5, TotalPages: 5},
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+	defer server.Close()
+
+	var ids []string
+	var gotErr error
+	for alert, err := range client.AlertsIterator(context.Background(), nil, WithPrefetch(2)) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		ids = append(ids, alert.ID)
+	}
+
+	if len(ids) != 2 {
+		t.Errorf("expected 2 alerts before the error, got %v", ids)
+	}
+	var apiErr *APIError
+	if !errors.As(gotErr, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("expected 500 API error, got %v", gotErr)
+	}
+}
+
+func TestPaginate_Limits(t *testing.T) {
+	var requests atomic.Int32
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		resp := ListNotificationsResponse{
+			Notifications: []Notification{{ID: "a"}, {ID: "b"}},
+			Pagination:    Pagination{Page: page, PageSize: 2, TotalItems: 2000000, TotalPages: 1000000},
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+	defer server.Close()
+
+	ctx := context.Background()
+	tests := []struct {
+		name         string
+		opts         []PaginationOption
+		wantItems    int
+		wantRequests int32
+	}{
+		{"max pages", []PaginationOption{WithMaxPages(3)}, 6, 3},
+		{"max pages with prefetch", []PaginationOption{WithMaxPages(3), WithPrefetch(5)}, 6, 3},
+		{"max items", []PaginationOption{WithMaxItems(5)}, 5, 3},
+		{"tighter limit wins", []PaginationOption{WithMaxItems(100), WithMaxPages(1)}, 2, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests.Store(0)
+			items, err := client.ListAllNotifications(ctx, "alert_123", nil, tt.opts...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(items) != tt.wantItems {
+				t.Errorf("expected %d items, got %d", tt.wantItems, len(items))
+			}
+			if n := requests.Load(); n != tt.wantRequests {
+				t.Errorf("expected %d requests, got %d", tt.wantRequests, n)
+			}
+		})
+	}
+}
+
+func TestPaginate_CancelMidStream(t *testing.T) {
+	for _, prefetch := range []int{0, 2} {
+		t.Run("prefetch "+strconv.Itoa(prefetch), func(t *testing.T) {
+			client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+				resp := ListAlertsResponse{
+					Alerts:     []Alert{{ID: "a"}, {ID: "b"}, {ID: "c"}},
+					Pagination: Pagination{Page: page, PageSize: 3, TotalItems: 30, TotalPages: 10},
+				}
+				json.NewEncoder(w).Encode(resp)
+			})
+			defer server.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			seen := 0
+			var gotErr error
+			for _, err := range client.AlertsIterator(ctx, nil, WithPrefetch(prefetch)) {
+				if err != nil {
+					gotErr = err
+					continue
+				}
+				seen++
+				if seen == 1 {
+					cancel()
+				}
+			}
+
+			if seen != 1 {
+				t.Errorf("expected iteration to stop after cancellation, saw %d items", seen)
+			}
+			if !errors.Is(gotErr, context.Canceled) {
+				t.Errorf("expected context.Canceled, got %v", gotErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package corestream
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// GetStreamProcessingStatus reports whether a stream's transcript is pending,
+// partial, complete, or failed.
+func (c *Client) GetStreamProcessingStatus(ctx context.Context, streamID string) (*StreamProcessingStatus, error) {
+	path := fmt.Sprintf("/v2/streams/%s/processing", streamID)
+	var status StreamProcessingStatus
+	if err := c.request(ctx, http.MethodGet, path, nil, nil, &status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// RequestReprocess queues a stream's transcript to be produced again with the
+// current ASR model, and returns the resulting status. The existing transcript
+// remains available until reprocessing completes.
+func (c *Client) RequestReprocess(ctx context.Context, streamID string) (*StreamProcessingStatus, error) {
+	path := fmt.Sprintf("/v2/streams/%s/reprocess", streamID)
+	var status StreamProcessingStatus
+	if err := c.request(ctx, http.MethodPost, path, nil, nil, &status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
@@ -0,0 +1,49 @@
+package corestream
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetStreamProcessingStatus(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/v2/streams/stream_1/processing" {
+			t.Errorf("expected path /v2/streams/stream_1/processing, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"stream_id":"stream_1","state":"partial","model_version":"asr-3","updated_at":"2024-01-01T12:00:00Z"}`))
+	})
+	defer server.Close()
+
+	status, err := client.GetStreamProcessingStatus(context.Background(), "stream_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status.State != ProcessingPartial || status.ModelVersion != "asr-3" {
+		t.Errorf("unexpected status: %+v", status)
+	}
+}
+
+func TestRequestReprocess(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/v2/streams/stream_1/reprocess" {
+			t.Errorf("expected path /v2/streams/stream_1/reprocess, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"stream_id":"stream_1","state":"pending","updated_at":"2024-01-01T12:00:00Z"}`))
+	})
+	defer server.Close()
+
+	status, err := client.RequestReprocess(context.Background(), "stream_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status.State != ProcessingPending {
+		t.Errorf("expected state pending, got %s", status.State)
+	}
+}
@@ -0,0 +1,242 @@
+package corestream
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var weekdayNames = [...]string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// WeekdaySet is a set of days of the week.
+// It is encoded in JSON as a list of three-letter day names, e.g. ["mon","fri"].
+type WeekdaySet uint8
+
+// Common weekday sets.
+const (
+	Weekdays WeekdaySet = 1<<time.Monday | 1<<time.Tuesday | 1<<time.Wednesday | 1<<time.Thursday | 1<<time.Friday
+	Weekends WeekdaySet = 1<<time.Saturday | 1<<time.Sunday
+	AllDays  WeekdaySet = Weekdays | Weekends
+)
+
+// NewWeekdaySet returns a set containing the given days.
+func NewWeekdaySet(days ...time.Weekday) WeekdaySet {
+	var s WeekdaySet
+	for _, d := range days {
+		s |= 1 << d
+	}
+	return s
+}
+
+// Contains reports whether d is in the set.
+func (s WeekdaySet) Contains(d time.Weekday) bool {
+	return s&(1<<d) != 0
+}
+
+// Days returns the days in the set, starting with Sunday.
+func (s WeekdaySet) Days() []time.Weekday {
+	var days []time.Weekday
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if s.Contains(d) {
+			days = append(days, d)
+		}
+	}
+	return days
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s WeekdaySet) MarshalJSON() ([]byte, error) {
+	names := []string{}
+	for _, d := range s.Days() {
+		names = append(names, weekdayNames[d])
+	}
+	return json.Marshal(names)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *WeekdaySet) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return err
+	}
+	var set WeekdaySet
+	for _, name := range names {
+		d, err := parseWeekday(name)
+		if err != nil {
+			return err
+		}
+		set |= 1 << d
+	}
+	*s = set
+	return nil
+}
+
+func parseWeekday(name string) (time.Weekday, error) {
+	lower := strings.ToLower(name)
+	for i, n := range weekdayNames {
+		if lower == n || lower == strings.ToLower(time.Weekday(i).String()) {
+			return time.Weekday(i), nil
+		}
+	}
+	return 0, fmt.Errorf("corestream: invalid weekday %q", name)
+}
+
+// TimeOfDay is a wall-clock time with minute precision, encoded as "HH:MM".
+type TimeOfDay struct {
+	Hour   int
+	Minute int
+}
+
+// ParseTimeOfDay parses a time in "HH:MM" (24-hour) format.
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return TimeOfDay{}, fmt.Errorf("corestream: invalid time of day %q, expected HH:MM", s)
+	}
+	return TimeOfDay{Hour: t.Hour(), Minute: t.Minute()}, nil
+}
+
+// String returns the time in "HH:MM" format.
+func (t TimeOfDay) String() string {
+	return fmt.Sprintf("%02d:%02d", t.Hour, t.Minute)
+}
+
+// Validate checks that the hour and minute are in range.
+func (t TimeOfDay) Validate() error {
+	if t.Hour < 0 || t.Hour > 23 || t.Minute < 0 || t.Minute > 59 {
+		return fmt.Errorf("corestream: invalid time of day %02d:%02d", t.Hour, t.Minute)
+	}
+	return nil
+}
+
+func (t TimeOfDay) minutes() int {
+	return t.Hour*60 + t.Minute
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *TimeOfDay) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := ParseTimeOfDay(s)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// ScheduleWindow is a daily time window. If End is before Start, the window
+// spans midnight (e.g. 22:00-06:00). Start is inclusive and End is exclusive.
+type ScheduleWindow struct {
+	Start TimeOfDay `json:"start"`
+	End   TimeOfDay `json:"end"`
+}
+
+// Validate checks that both ends of the window are valid and not equal.
+func (r ScheduleWindow) Validate() error {
+	if err := r.Start.Validate(); err != nil {
+		return err
+	}
+	if err := r.End.Validate(); err != nil {
+		return err
+	}
+	if r.Start == r.End {
+		return fmt.Errorf("corestream: schedule window %s-%s is empty", r.Start, r.End)
+	}
+	return nil
+}
+
+// Contains reports whether the wall-clock time of t falls within the window.
+func (r ScheduleWindow) Contains(t time.Time) bool {
+	m := t.Hour()*60 + t.Minute()
+	start, end := r.Start.minutes(), r.End.minutes()
+	if start < end {
+		return m >= start && m < end
+	}
+	return m >= start || m < end
+}
+
+// Timezone is an IANA time zone name such as "America/New_York",
+// encoded in JSON as a string.
+type Timezone string
+
+// Location loads the time zone. An empty Timezone is UTC.
+func (tz Timezone) Location() (*time.Location, error) {
+	if tz == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(string(tz))
+	if err != nil {
+		return nil, fmt.Errorf("corestream: invalid timezone %q: %w", string(tz), err)
+	}
+	return loc, nil
+}
+
+// Validate checks that the time zone is known.
+func (tz Timezone) Validate() error {
+	_, err := tz.Location()
+	return err
+}
+
+// Schedule describes when something is in effect: on the given days, during
+// any of the given time windows, evaluated in the given time zone.
+// A schedule with no windows covers the whole day.
+type Schedule struct {
+	Days     WeekdaySet       `json:"days"`
+	Windows  []ScheduleWindow `json:"windows,omitempty"`
+	Timezone Timezone         `json:"timezone,omitempty"`
+}
+
+// Validate checks the schedule for invalid days, windows, or time zone.
+func (s *Schedule) Validate() error {
+	if s.Days == 0 {
+		return errors.New("corestream: schedule must include at least one day")
+	}
+	for _, w := range s.Windows {
+		if err := w.Validate(); err != nil {
+			return err
+		}
+	}
+	return s.Timezone.Validate()
+}
+
+// Active reports whether t falls within the schedule. Windows spanning
+// midnight belong to the day on which they start.
+func (s *Schedule) Active(t time.Time) (bool, error) {
+	loc, err := s.Timezone.Location()
+	if err != nil {
+		return false, err
+	}
+	local := t.In(loc)
+
+	if len(s.Windows) == 0 {
+		return s.Days.Contains(local.Weekday()), nil
+	}
+
+	for _, w := range s.Windows {
+		if !w.Contains(local) {
+			continue
+		}
+		day := local.Weekday()
+		if w.End.minutes() < w.Start.minutes() && local.Hour()*60+local.Minute() < w.End.minutes() {
+			// Early-morning part of an overnight window started the previous day.
+			day = (day + 6) % 7
+		}
+		if s.Days.Contains(day) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
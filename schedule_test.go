@@ -0,0 +1,141 @@
+package corestream
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestWeekdaySet_JSON(t *testing.T) {
+	set := NewWeekdaySet(time.Monday, time.Friday)
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != `["mon","fri"]` {
+		t.Errorf("expected [\"mon\",\"fri\"], got %s", data)
+	}
+
+	var decoded WeekdaySet
+	if err := json.Unmarshal([]byte(`["Mon","friday"]`), &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded != set {
+		t.Errorf("expected %v, got %v", set.Days(), decoded.Days())
+	}
+
+	if err := json.Unmarshal([]byte(`["someday"]`), &decoded); err == nil {
+		t.Error("expected error for invalid weekday")
+	}
+}
+
+func TestTimeOfDay_JSON(t *testing.T) {
+	var tod TimeOfDay
+	if err := json.Unmarshal([]byte(`"09:30"`), &tod); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tod.Hour != 9 || tod.Minute != 30 {
+		t.Errorf("expected 09:30, got %s", tod)
+	}
+
+	data, err := json.Marshal(TimeOfDay{Hour: 7, Minute: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != `"07:05"` {
+		t.Errorf("expected \"07:05\", got %s", data)
+	}
+
+	if err := json.Unmarshal([]byte(`"25:00"`), &tod); err == nil {
+		t.Error("expected error for invalid time")
+	}
+	if _, err := json.Marshal(TimeOfDay{Hour: 24}); err == nil {
+		t.Error("expected error marshalling invalid time")
+	}
+}
+
+func TestSchedule_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule Schedule
+		wantErr  bool
+	}{
+		{"valid", Schedule{Days: Weekdays, Windows: []ScheduleWindow{{Start: TimeOfDay{9, 0}, End: TimeOfDay{17, 0}}}, Timezone: "Europe/Berlin"}, false},
+		{"no days", Schedule{Timezone: "UTC"}, true},
+		{"empty window", Schedule{Days: Weekdays, Windows: []ScheduleWindow{{Start: TimeOfDay{9, 0}, End: TimeOfDay{9, 0}}}}, true},
+		{"invalid timezone", Schedule{Days: Weekdays, Timezone: "Mars/Olympus"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSchedule_Active(t *testing.T) {
+	// Business hours in New York, Monday to Friday.
+	schedule := Schedule{
+		Days:     Weekdays,
+		Windows:  []ScheduleWindow{{Start: TimeOfDay{9, 0}, End: TimeOfDay{17, 0}}},
+		Timezone: "America/New_York",
+	}
+	// Overnight window starting Friday evening.
+	overnight := Schedule{
+		Days:    NewWeekdaySet(time.Friday),
+		Windows: []ScheduleWindow{{Start: TimeOfDay{22, 0}, End: TimeOfDay{6, 0}}},
+	}
+
+	tests := []struct {
+		name     string
+		schedule Schedule
+		at       time.Time
+		want     bool
+	}{
+		{"inside window", schedule, time.Date(2024, 3, 4, 15, 0, 0, 0, time.UTC), true},    // Mon 10:00 EST
+		{"before window", schedule, time.Date(2024, 3, 4, 13, 0, 0, 0, time.UTC), false},   // Mon 08:00 EST
+		{"weekend", schedule, time.Date(2024, 3, 9, 15, 0, 0, 0, time.UTC), false},         // Sat 10:00 EST
+		{"overnight start", overnight, time.Date(2024, 3, 8, 23, 0, 0, 0, time.UTC), true}, // Fri 23:00
+		{"overnight end", overnight, time.Date(2024, 3, 9, 5, 0, 0, 0, time.UTC), true},    // Sat 05:00
+		{"overnight wrong day", overnight, time.Date(2024, 3, 8, 5, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.schedule.Active(tt.at)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSchedule_JSON(t *testing.T) {
+	schedule := Schedule{
+		Days:     Weekdays,
+		Windows:  []ScheduleWindow{{Start: TimeOfDay{9, 0}, End: TimeOfDay{17, 30}}},
+		Timezone: "Europe/London",
+	}
+	data, err := json.Marshal(schedule)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"days":["mon","tue","wed","thu","fri"],"windows":[{"start":"09:00","end":"17:30"}],"timezone":"Europe/London"}`
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+
+	var decoded Schedule
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded.Days != schedule.Days || decoded.Windows[0] != schedule.Windows[0] || decoded.Timezone != schedule.Timezone {
+		t.Errorf("round trip mismatch: %+v", decoded)
+	}
+}
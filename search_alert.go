@@ -0,0 +1,79 @@
+package corestream
+
+import (
+	"context"
+	"strings"
+)
+
+// AlertRequestFromSearch returns an alert request that watches for new
+// matches of a stream search. Each quoted phrase and each remaining word of
+// query becomes one of the alert's phrases, and opts.StreamerIDs and
+// opts.Categories carry over. The alert is named after the query. Options
+// that only shape results, such as time ranges, sorting, and highlights, are
+// ignored. Semantic searches and language filters have no alert equivalent
+// and are reported in the returned ValidationErrors, along with any problem
+// found by CreateAlertRequest.Validate. opts may be nil.
+func AlertRequestFromSearch(query string, opts *SearchStreamsOptions) (*CreateAlertRequest, error) {
+	if opts == nil {
+		opts = &SearchStreamsOptions{}
+	}
+	req := &CreateAlertRequest{
+		Name:        strings.Join(strings.Fields(query), " "),
+		Phrases:     searchQueryPhrases(query),
+		StreamerIDs: opts.StreamerIDs,
+		Categories:  opts.Categories,
+	}
+
+	var v validator
+	if opts.Mode == SearchSemantic {
+		v.addf("mode", "semantic searches cannot be converted to alerts")
+	}
+	if len(opts.Languages) > 0 {
+		v.addf("languages", "alerts cannot filter by language")
+	}
+	if err := req.Validate(); err != nil {
+		v.errs = append(v.errs, err.(ValidationErrors)...)
+	}
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// CreateAlertFromSearch saves a stream search as an alert, as described by
+// AlertRequestFromSearch. To customize the alert before creating it, call
+// AlertRequestFromSearch and CreateAlert instead. opts may be nil.
+func (c *Client) CreateAlertFromSearch(ctx context.Context, query string, opts *SearchStreamsOptions) (*Alert, error) {
+	req, err := AlertRequestFromSearch(query, opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.CreateAlert(ctx, req)
+}
+
+// searchQueryPhrases splits a search query into its "quoted phrases" and
+// individual words. An unterminated quote runs to the end of the query.
+// Search is case-insensitive, so only the first of phrases differing in case
+// is kept.
+func searchQueryPhrases(query string) []string {
+	var phrases []string
+	seen := make(map[string]bool)
+	add := func(phrase string) {
+		if key := strings.ToLower(phrase); !seen[key] {
+			seen[key] = true
+			phrases = append(phrases, phrase)
+		}
+	}
+	for i, part := range strings.Split(query, `"`) {
+		if i%2 == 1 {
+			if phrase := strings.Join(strings.Fields(part), " "); phrase != "" {
+				add(phrase)
+			}
+			continue
+		}
+		for _, word := range strings.Fields(part) {
+			add(word)
+		}
+	}
+	return phrases
+}
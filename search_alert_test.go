@@ -0,0 +1,81 @@
+package corestream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestAlertRequestFromSearch(t *testing.T) {
+	opts := &SearchStreamsOptions{
+		TimeRange:   TimeRangeWeek,
+		StreamerIDs: []string{"streamer_a"},
+		Categories:  []string{"Valorant"},
+		SortBy:      SearchSortByDate,
+	}
+	req, err := AlertRequestFromSearch(`acme  "new   patch" launch "unterminated phrase`, opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wantPhrases := []string{"acme", "new patch", "launch", "unterminated phrase"}
+	if !slices.Equal(req.Phrases, wantPhrases) {
+		t.Errorf("phrases = %q, want %q", req.Phrases, wantPhrases)
+	}
+	if req.Name != `acme "new patch" launch "unterminated phrase` {
+		t.Errorf("unexpected name %q", req.Name)
+	}
+	if !slices.Equal(req.StreamerIDs, opts.StreamerIDs) || !slices.Equal(req.Categories, opts.Categories) {
+		t.Errorf("filters not carried over: %+v", req)
+	}
+}
+
+func TestAlertRequestFromSearch_RepeatedWords(t *testing.T) {
+	req, err := AlertRequestFromSearch(`Go go "GO"`, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"Go"}; !slices.Equal(req.Phrases, want) {
+		t.Errorf("phrases = %q, want %q", req.Phrases, want)
+	}
+}
+
+func TestAlertRequestFromSearch_Unsupported(t *testing.T) {
+	_, err := AlertRequestFromSearch("a", &SearchStreamsOptions{Mode: SearchSemantic, Languages: []string{"en"}})
+	var errs ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected ValidationErrors, got %v", err)
+	}
+	fields := make([]string, len(errs))
+	for i, e := range errs {
+		fields[i] = e.Field
+	}
+	if want := []string{"mode", "languages", "phrases[0]"}; !slices.Equal(fields, want) {
+		t.Errorf("fields = %q, want %q", fields, want)
+	}
+}
+
+func TestCreateAlertFromSearch(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v2/alerts" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var req CreateAlertRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if !slices.Equal(req.Phrases, []string{"acme", "big sale"}) {
+			t.Errorf("unexpected phrases %q", req.Phrases)
+		}
+		json.NewEncoder(w).Encode(Alert{ID: "alert_1", Name: req.Name, Phrases: req.Phrases})
+	})
+	defer server.Close()
+
+	alert, err := client.CreateAlertFromSearch(context.Background(), `acme "big sale"`, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if alert.ID != "alert_1" {
+		t.Errorf("unexpected alert: %+v", alert)
+	}
+}
@@ -0,0 +1,141 @@
+package corestream
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WithSearchCache enables an in-memory cache for SearchStreams results.
+// Results younger than ttl are served from the cache. Results older than ttl
+// but younger than ttl+staleTTL are served from the cache while a single
+// background request refreshes them (stale-while-revalidate). Older results
+// are fetched synchronously.
+func WithSearchCache(ttl, staleTTL time.Duration) Option {
+	return func(c *Client) error {
+		if ttl <= 0 {
+			return fmt.Errorf("corestream: search cache TTL must be positive")
+		}
+		if staleTTL < 0 {
+			return fmt.Errorf("corestream: search cache stale TTL cannot be negative")
+		}
+		c.searchCache = newSearchCache(ttl, staleTTL)
+		return nil
+	}
+}
+
+type searchCacheEntry struct {
+	resp       *SearchStreamsResponse
+	fetchedAt  time.Time
+	refreshing bool
+}
+
+type searchCache struct {
+	ttl      time.Duration
+	staleTTL time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*searchCacheEntry
+}
+
+func newSearchCache(ttl, staleTTL time.Duration) *searchCache {
+	return &searchCache{
+		ttl:      ttl,
+		staleTTL: staleTTL,
+		entries:  make(map[string]*searchCacheEntry),
+	}
+}
+
+// searchCacheKey builds a cache key from search parameters, normalizing the
+// query so that equivalent searches share an entry.
+func searchCacheKey(params url.Values) string {
+	normalized := url.Values{}
+	for k, v := range params {
+		normalized[k] = v
+	}
+	normalized.Set("q", strings.ToLower(strings.Join(strings.Fields(params.Get("q")), " ")))
+	return normalized.Encode()
+}
+
+type searchFetchFunc func(ctx context.Context) (*SearchStreamsResponse, error)
+
+func (sc *searchCache) get(ctx context.Context, key string, fetch searchFetchFunc) (*SearchStreamsResponse, error) {
+	sc.mu.Lock()
+	entry, ok := sc.entries[key]
+	if ok {
+		age := time.Since(entry.fetchedAt)
+		if age < sc.ttl {
+			resp := entry.resp
+			sc.mu.Unlock()
+			return copySearchResponse(resp), nil
+		}
+		if age < sc.ttl+sc.staleTTL {
+			resp := entry.resp
+			if !entry.refreshing {
+				entry.refreshing = true
+				go sc.refresh(context.WithoutCancel(ctx), key, fetch)
+			}
+			sc.mu.Unlock()
+			return copySearchResponse(resp), nil
+		}
+	}
+	sc.mu.Unlock()
+
+	resp, err := fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sc.store(key, resp)
+	return copySearchResponse(resp), nil
+}
+
+func (sc *searchCache) refresh(ctx context.Context, key string, fetch searchFetchFunc) {
+	resp, err := fetch(ctx)
+	if err != nil {
+		// Keep serving the stale entry; the next request past the stale
+		// window will fetch synchronously and surface the error.
+		sc.mu.Lock()
+		if entry, ok := sc.entries[key]; ok {
+			entry.refreshing = false
+		}
+		sc.mu.Unlock()
+		return
+	}
+	sc.store(key, resp)
+}
+
+func (sc *searchCache) store(key string, resp *SearchStreamsResponse) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	now := time.Now()
+	for k, entry := range sc.entries {
+		if now.Sub(entry.fetchedAt) >= sc.ttl+sc.staleTTL && !entry.refreshing {
+			delete(sc.entries, k)
+		}
+	}
+	sc.entries[key] = &searchCacheEntry{resp: resp, fetchedAt: now}
+}
+
+// copySearchResponse returns a deep copy of resp so callers can't modify a
+// cached response.
+func copySearchResponse(resp *SearchStreamsResponse) *SearchStreamsResponse {
+	cp := *resp
+	cp.Results = slices.Clone(resp.Results)
+	for i := range cp.Results {
+		cp.Results[i].Highlights = slices.Clone(cp.Results[i].Highlights)
+		cp.Results[i].Matches = slices.Clone(cp.Results[i].Matches)
+	}
+	if resp.Aggregations != nil {
+		cp.Aggregations = make(map[SearchAggregation][]AggregationBucket, len(resp.Aggregations))
+		for k, buckets := range resp.Aggregations {
+			cp.Aggregations[k] = slices.Clone(buckets)
+		}
+	}
+	cp.Suggestions = slices.Clone(resp.Suggestions)
+	return &cp
+}
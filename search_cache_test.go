@@ -0,0 +1,142 @@
+package corestream
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithSearchCache(t *testing.T) {
+	var requests atomic.Int32
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Add(1)
+		resp := SearchStreamsResponse{
+			Results: []SearchResult{
+				{StreamID: "stream_123", Title: "Stream"},
+			},
+			Pagination: Pagination{Page: 1, PageSize: 10, TotalItems: int(n), TotalPages: 1},
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+	defer server.Close()
+
+	if err := WithSearchCache(50*time.Millisecond, time.Minute)(client); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	first, err := client.SearchStreams(ctx, "gaming setup", 1, 10, "week")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Equivalent query within the TTL is served from the cache.
+	second, err := client.SearchStreams(ctx, "  Gaming   Setup ", 1, 10, "week")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests.Load() != 1 {
+		t.Fatalf("expected 1 request, got %d", requests.Load())
+	}
+	if second.Pagination.TotalItems != first.Pagination.TotalItems {
+		t.Errorf("expected cached response")
+	}
+
+	// Different options are cached separately.
+	if _, err := client.SearchStreams(ctx, "gaming setup", 2, 10, "week"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests.Load() != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests.Load())
+	}
+
+	// A stale entry is served immediately and refreshed in the background.
+	time.Sleep(60 * time.Millisecond)
+	stale, err := client.SearchStreams(ctx, "gaming setup", 1, 10, "week")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stale.Pagination.TotalItems != 1 {
+		t.Errorf("expected stale response, got total %d", stale.Pagination.TotalItems)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for requests.Load() < 3 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if requests.Load() != 3 {
+		t.Fatalf("expected background refresh, got %d requests", requests.Load())
+	}
+
+	deadline = time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		fresh, err := client.SearchStreams(ctx, "gaming setup", 1, 10, "week")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if fresh.Pagination.TotalItems == 3 {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Error("expected refreshed response to be served")
+}
+
+func TestWithSearchCache_CallerMutation(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"results": [{
+				"stream_id": "stream_123",
+				"highlights": ["<em>gaming</em> setup"],
+				"matches": [{"highlight": "<em>gaming</em> setup", "match_start": 12.5}]
+			}],
+			"aggregations": {"streamer": [{"key": "streamer_123", "count": 4}]},
+			"suggestions": ["gaming setup"]
+		}`))
+	})
+	defer server.Close()
+
+	if err := WithSearchCache(time.Minute, time.Minute)(client); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	first, err := client.SearchStreams(ctx, "gaming setup", 1, 10, "week")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	first.Results[0].Highlights[0] = "changed"
+	first.Results[0].Matches[0].MatchStart = 0
+	first.Aggregations[AggregateByStreamer][0].Count = 0
+	first.Suggestions[0] = "changed"
+
+	second, err := client.SearchStreams(ctx, "gaming setup", 1, 10, "week")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := second.Results[0].Highlights[0]; got != "<em>gaming</em> setup" {
+		t.Errorf("expected cached highlight to be unchanged, got %q", got)
+	}
+	if got := second.Results[0].Matches[0].MatchStart; got != 12.5 {
+		t.Errorf("expected cached match start 12.5, got %v", got)
+	}
+	if got := second.Aggregations[AggregateByStreamer][0].Count; got != 4 {
+		t.Errorf("expected cached bucket count 4, got %d", got)
+	}
+	if got := second.Suggestions[0]; got != "gaming setup" {
+		t.Errorf("expected cached suggestion to be unchanged, got %q", got)
+	}
+}
+
+func TestWithSearchCache_InvalidTTL(t *testing.T) {
+	if _, err := NewClient("token", WithSearchCache(0, time.Minute)); err == nil {
+		t.Error("expected error for zero TTL")
+	}
+	if _, err := NewClient("token", WithSearchCache(time.Minute, -time.Second)); err == nil {
+		t.Error("expected error for negative stale TTL")
+	}
+}
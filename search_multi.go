@@ -0,0 +1,49 @@
+package corestream
+
+import (
+	"context"
+	"sync"
+)
+
+// maxMultiSearchConcurrency bounds the searches SearchStreamsMulti runs at
+// once.
+const maxMultiSearchConcurrency = 4
+
+// SearchRequest is one query in a SearchStreamsMulti call. Options may be nil.
+type SearchRequest struct {
+	Query   string
+	Options *SearchStreamsOptions
+}
+
+// MultiSearchResult is the outcome of one query in a SearchStreamsMulti call.
+// Exactly one of Response and Err is set.
+type MultiSearchResult struct {
+	Response *SearchStreamsResponse
+	Err      error
+}
+
+// SearchStreamsMulti runs several stream searches, a few at a time, and
+// returns their results in the same order as queries. A failed query does not
+// stop the others. Searches go through the client's search cache when one is
+// enabled with WithSearchCache.
+func (c *Client) SearchStreamsMulti(ctx context.Context, queries []SearchRequest) []MultiSearchResult {
+	results := make([]MultiSearchResult, len(queries))
+	sem := make(chan struct{}, maxMultiSearchConcurrency)
+	var wg sync.WaitGroup
+	for i, q := range queries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				results[i].Err = ctx.Err()
+				return
+			}
+			results[i].Response, results[i].Err = c.SearchStreamsWithOptions(ctx, q.Query, q.Options)
+		}()
+	}
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,61 @@
+package corestream
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSearchStreamsMulti(t *testing.T) {
+	var active, peak atomic.Int32
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		n := active.Add(1)
+		defer active.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		q := r.URL.Query().Get("q")
+		if q == "fail" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code":"invalid_query","message":"bad query"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(SearchStreamsResponse{Results: []SearchResult{{StreamID: q}}})
+	})
+	defer server.Close()
+
+	queries := []SearchRequest{
+		{Query: "q0"}, {Query: "q1", Options: &SearchStreamsOptions{TimeRange: TimeRangeWeek}},
+		{Query: "fail"}, {Query: "q3"}, {Query: "q4"}, {Query: "q5"},
+	}
+	results := client.SearchStreamsMulti(context.Background(), queries)
+	if len(results) != len(queries) {
+		t.Fatalf("expected %d results, got %d", len(queries), len(results))
+	}
+	for i, res := range results {
+		if queries[i].Query == "fail" {
+			if res.Err == nil || res.Response != nil {
+				t.Errorf("result %d: expected error, got %+v", i, res)
+			}
+			continue
+		}
+		if res.Err != nil {
+			t.Errorf("result %d: unexpected error: %v", i, res.Err)
+			continue
+		}
+		if got := res.Response.Results[0].StreamID; got != queries[i].Query {
+			t.Errorf("result %d: got results for %q", i, got)
+		}
+	}
+	if p := peak.Load(); p > maxMultiSearchConcurrency {
+		t.Errorf("expected at most %d concurrent searches, got %d", maxMultiSearchConcurrency, p)
+	}
+}
@@ -0,0 +1,182 @@
+package corestream
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Signing key algorithms.
+const (
+	SigningAlgorithmEd25519   = "ed25519"
+	SigningAlgorithmECDSAP256 = "ecdsa-p256-sha256"
+)
+
+const (
+	// signingKeyCacheTTL is how long FetchSigningKeys serves keys from memory.
+	signingKeyCacheTTL = time.Hour
+
+	// signingKeyRefreshInterval limits how often keys are refetched because a
+	// delivery named a key that was not in the cache.
+	signingKeyRefreshInterval = time.Minute
+
+	// signingKeyFetchTimeout bounds a fetch shared by several callers, which
+	// does not stop when any one of them gives up.
+	signingKeyFetchTimeout = 30 * time.Second
+)
+
+// signingKeyCache holds the most recently fetched signing keys.
+type signingKeyCache struct {
+	mu          sync.Mutex
+	keys        []SigningKey
+	fetchedAt   time.Time
+	attemptedAt time.Time
+	fetch       *signingKeyFetch
+}
+
+// signingKeyFetch is a fetch of the signing keys shared by concurrent callers.
+type signingKeyFetch struct {
+	done chan struct{}
+	keys []SigningKey
+	err  error
+}
+
+// refreshSigningKeysKey marks a context whose FetchSigningKeys call should
+// bypass the cache, subject to signingKeyRefreshInterval.
+type refreshSigningKeysKey struct{}
+
+// FetchSigningKeys retrieves the public keys used to sign webhook deliveries.
+// Keys are cached in memory for an hour. When used as a SigningKeySource, the
+// cache is refreshed early, at most once a minute, if a delivery names a key
+// it does not contain, so rotated keys are picked up promptly.
+func (c *Client) FetchSigningKeys(ctx context.Context) ([]SigningKey, error) {
+	_, refresh := ctx.Value(refreshSigningKeysKey{}).(bool)
+
+	sk := &c.signingKeys
+	sk.mu.Lock()
+	fresh := sk.keys != nil && time.Since(sk.fetchedAt) < signingKeyCacheTTL &&
+		!(refresh && time.Since(sk.attemptedAt) >= signingKeyRefreshInterval)
+	if fresh {
+		keys := slices.Clone(sk.keys)
+		sk.mu.Unlock()
+		return keys, nil
+	}
+	f := sk.fetch
+	if f == nil {
+		f = &signingKeyFetch{done: make(chan struct{})}
+		sk.fetch = f
+		sk.attemptedAt = time.Now()
+		go c.fetchSigningKeys(context.WithoutCancel(ctx), f)
+	}
+	sk.mu.Unlock()
+
+	select {
+	case <-f.done:
+		if f.err != nil {
+			return nil, f.err
+		}
+		return slices.Clone(f.keys), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *Client) fetchSigningKeys(ctx context.Context, f *signingKeyFetch) {
+	ctx, cancel := context.WithTimeout(ctx, signingKeyFetchTimeout)
+	defer cancel()
+
+	var resp ListSigningKeysResponse
+	f.err = c.request(ctx, http.MethodGet, "/v2/webhooks/signing-keys", nil, nil, &resp)
+	f.keys = resp.Keys
+	if f.keys == nil {
+		f.keys = []SigningKey{}
+	}
+
+	sk := &c.signingKeys
+	sk.mu.Lock()
+	if f.err == nil {
+		sk.keys = f.keys
+		sk.fetchedAt = time.Now()
+	}
+	sk.fetch = nil
+	sk.mu.Unlock()
+	close(f.done)
+}
+
+// withSigningKeyRefresh asks a SigningKeySource backed by FetchSigningKeys to
+// bypass its cache.
+func withSigningKeyRefresh(ctx context.Context) context.Context {
+	return context.WithValue(ctx, refreshSigningKeysKey{}, true)
+}
+
+// refreshLimiter allows an action at most once per interval.
+type refreshLimiter struct {
+	mu   sync.Mutex
+	last time.Time
+}
+
+func (l *refreshLimiter) allow(interval time.Duration) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.last.IsZero() && time.Since(l.last) < interval {
+		return false
+	}
+	l.last = time.Now()
+	return true
+}
+
+// findSigningKey returns the key with the given ID, or nil.
+func findSigningKey(keys []SigningKey, keyID string) *SigningKey {
+	for i := range keys {
+		if keys[i].ID == keyID {
+			return &keys[i]
+		}
+	}
+	return nil
+}
+
+// VerifyWebhookSignatureWithKey verifies an asymmetric webhook signature
+// using a public signing key. The signature is hex-encoded.
+// This is useful for manual webhook handling outside of WebhookReceiver.
+func VerifyWebhookSignatureWithKey(body []byte, signature string, key SigningKey) (bool, error) {
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		return false, nil
+	}
+
+	der, err := base64.StdEncoding.DecodeString(key.PublicKey)
+	if err != nil {
+		return false, fmt.Errorf("corestream: invalid public key encoding for key %s: %w", key.ID, err)
+	}
+	pub, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return false, fmt.Errorf("corestream: invalid public key for key %s: %w", key.ID, err)
+	}
+
+	switch key.Algorithm {
+	case SigningAlgorithmEd25519:
+		edKey, ok := pub.(ed25519.PublicKey)
+		if !ok {
+			return false, fmt.Errorf("corestream: key %s is not an Ed25519 key", key.ID)
+		}
+		return ed25519.Verify(edKey, body, sig), nil
+	case SigningAlgorithmECDSAP256:
+		ecKey, ok := pub.(*ecdsa.PublicKey)
+		if !ok {
+			return false, fmt.Errorf("corestream: key %s is not an ECDSA key", key.ID)
+		}
+		digest := sha256.Sum256(body)
+		return ecdsa.VerifyASN1(ecKey, digest[:], sig), nil
+	default:
+		return false, fmt.Errorf("corestream: unsupported signing algorithm %q", key.Algorithm)
+	}
+}
@@ -0,0 +1,359 @@
+package corestream
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newEd25519SigningKey(t *testing.T, id string) (SigningKey, ed25519.PrivateKey) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return SigningKey{
+		ID:        id,
+		Algorithm: SigningAlgorithmEd25519,
+		PublicKey: base64.StdEncoding.EncodeToString(der),
+	}, priv
+}
+
+func TestFetchSigningKeys(t *testing.T) {
+	requests := 0
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/v2/webhooks/signing-keys" {
+			t.Errorf("expected path /v2/webhooks/signing-keys, got %s", r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(ListSigningKeysResponse{
+			Keys: []SigningKey{{ID: "key_1", Algorithm: SigningAlgorithmEd25519, PublicKey: "abc"}},
+		})
+	})
+	defer server.Close()
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		keys, err := client.FetchSigningKeys(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(keys) != 1 || keys[0].ID != "key_1" {
+			t.Errorf("unexpected keys %+v", keys)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("expected keys to be cached, got %d requests", requests)
+	}
+}
+
+func TestVerifyWebhookSignatureWithKey(t *testing.T) {
+	body := []byte(`{"id":"test"}`)
+
+	t.Run("ed25519", func(t *testing.T) {
+		key, priv := newEd25519SigningKey(t, "key_1")
+		sig := hex.EncodeToString(ed25519.Sign(priv, body))
+
+		ok, err := VerifyWebhookSignatureWithKey(body, sig, key)
+		if err != nil || !ok {
+			t.Errorf("expected valid signature, got %v, %v", ok, err)
+		}
+
+		ok, err = VerifyWebhookSignatureWithKey([]byte(`{"id":"tampered"}`), sig, key)
+		if err != nil || ok {
+			t.Errorf("expected invalid signature, got %v, %v", ok, err)
+		}
+	})
+
+	t.Run("ecdsa", func(t *testing.T) {
+		priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		der, err := x509.MarshalPKIXPublicKey(&priv.PublicKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		key := SigningKey{ID: "key_2", Algorithm: SigningAlgorithmECDSAP256, PublicKey: base64.StdEncoding.EncodeToString(der)}
+
+		digest := sha256.Sum256(body)
+		rawSig, err := ecdsa.SignASN1(rand.Reader, priv, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ok, err := VerifyWebhookSignatureWithKey(body, hex.EncodeToString(rawSig), key)
+		if err != nil || !ok {
+			t.Errorf("expected valid signature, got %v, %v", ok, err)
+		}
+	})
+
+	t.Run("unsupported algorithm", func(t *testing.T) {
+		key, priv := newEd25519SigningKey(t, "key_1")
+		key.Algorithm = "rsa"
+		sig := hex.EncodeToString(ed25519.Sign(priv, body))
+
+		if _, err := VerifyWebhookSignatureWithKey(body, sig, key); err == nil {
+			t.Error("expected error for unsupported algorithm")
+		}
+	})
+}
+
+func TestWebhookReceiver_SigningKeys(t *testing.T) {
+	key, priv := newEd25519SigningKey(t, "key_1")
+	source := func(ctx context.Context) ([]SigningKey, error) {
+		return []SigningKey{key}, nil
+	}
+	body := []byte(`{"id":"notif_123","alert_id":"alert_456"}`)
+
+	tests := []struct {
+		name      string
+		keyID     string
+		signature string
+		source    SigningKeySource
+		want      int
+	}{
+		{"valid signature", "key_1", hex.EncodeToString(ed25519.Sign(priv, body)), source, http.StatusOK},
+		{"invalid signature", "key_1", hex.EncodeToString(ed25519.Sign(priv, []byte("other"))), source, http.StatusUnauthorized},
+		{"unknown key", "key_2", hex.EncodeToString(ed25519.Sign(priv, body)), source, http.StatusUnauthorized},
+		{"source error", "key_1", hex.EncodeToString(ed25519.Sign(priv, body)), func(ctx context.Context) ([]SigningKey, error) {
+			return nil, errors.New("unavailable")
+		}, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receiver := NewWebhookReceiver("", func(n *WebhookNotification) error {
+				return nil
+			}, WithSigningKeys(tt.source))
+
+			req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+			req.Header.Set(SignatureHeader, tt.signature)
+			req.Header.Set(SignatureKeyHeader, tt.keyID)
+			rec := httptest.NewRecorder()
+
+			receiver.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Errorf("expected status %d, got %d", tt.want, rec.Code)
+			}
+		})
+	}
+}
+
+func TestWebhookReceiver_MixedKeys(t *testing.T) {
+	key, priv := newEd25519SigningKey(t, "key_2")
+	source := func(ctx context.Context) ([]SigningKey, error) {
+		return []SigningKey{key}, nil
+	}
+	body := []byte(`{"id":"notif_123","alert_id":"alert_456"}`)
+	edSig := hex.EncodeToString(ed25519.Sign(priv, body))
+
+	tests := []struct {
+		name      string
+		keyID     string
+		signature string
+		opts      []WebhookReceiverOption
+		want      int
+	}{
+		{"keyring secret", "key_1", generateSignature(body, "keyring-secret"), nil, http.StatusOK},
+		{"signing key", "key_2", edSig, nil, http.StatusOK},
+		{"unknown key falls back to default secret", "key_3", generateSignature(body, "default-secret"), nil, http.StatusOK},
+		{"unknown key falls back to previous secret", "key_3", generateSignature(body, "old-secret"),
+			[]WebhookReceiverOption{WithPreviousSecret("old-secret", time.Time{})}, http.StatusOK},
+		{"unknown key with wrong secret", "key_3", generateSignature(body, "other-secret"), nil, http.StatusUnauthorized},
+		{"known key does not fall back", "key_1", generateSignature(body, "default-secret"), nil, http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]WebhookReceiverOption{
+				WithSecrets(map[string]string{"key_1": "keyring-secret"}),
+				WithSigningKeys(source),
+			}, tt.opts...)
+			receiver := NewWebhookReceiver("default-secret", func(n *WebhookNotification) error {
+				return nil
+			}, opts...)
+
+			req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+			req.Header.Set(SignatureHeader, tt.signature)
+			req.Header.Set(SignatureKeyHeader, tt.keyID)
+			rec := httptest.NewRecorder()
+			receiver.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Errorf("expected status %d, got %d", tt.want, rec.Code)
+			}
+		})
+	}
+
+	t.Run("keyring only", func(t *testing.T) {
+		receiver := NewWebhookReceiver("default-secret", func(n *WebhookNotification) error {
+			return nil
+		}, WithSecrets(map[string]string{"key_1": "keyring-secret"}))
+
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+		req.Header.Set(SignatureHeader, generateSignature(body, "default-secret"))
+		req.Header.Set(SignatureKeyHeader, "key_3")
+		rec := httptest.NewRecorder()
+		receiver.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Errorf("expected status 200, got %d", rec.Code)
+		}
+	})
+}
+
+func TestFetchSigningKeys_ReturnsCopy(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(ListSigningKeysResponse{Keys: []SigningKey{{ID: "key_1"}}})
+	})
+	defer server.Close()
+
+	keys, err := client.FetchSigningKeys(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	keys[0].ID = "mutated"
+
+	keys, err = client.FetchSigningKeys(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if keys[0].ID != "key_1" {
+		t.Errorf("cached keys were mutated: %+v", keys)
+	}
+}
+
+func TestFetchSigningKeys_SlowFetchDoesNotBlockCallers(t *testing.T) {
+	release := make(chan struct{})
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		json.NewEncoder(w).Encode(ListSigningKeysResponse{Keys: []SigningKey{{ID: "key_1"}}})
+	})
+	defer server.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := client.FetchSigningKeys(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+
+	// The lock is not held during the fetch, so a second caller can give up too.
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel2()
+	if _, err := client.FetchSigningKeys(ctx2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestWebhookReceiver_SigningKeyRotation(t *testing.T) {
+	oldKey, oldPriv := newEd25519SigningKey(t, "key_1")
+	newKey, newPriv := newEd25519SigningKey(t, "key_2")
+	current := []SigningKey{oldKey}
+	requests := 0
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		json.NewEncoder(w).Encode(ListSigningKeysResponse{Keys: current})
+	})
+	defer server.Close()
+
+	receiver := NewWebhookReceiver("", func(n *WebhookNotification) error {
+		return nil
+	}, WithSigningKeys(client.FetchSigningKeys))
+	body := []byte(`{"id":"notif_123","alert_id":"alert_456"}`)
+	send := func(keyID string, priv ed25519.PrivateKey) int {
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+		req.Header.Set(SignatureHeader, hex.EncodeToString(ed25519.Sign(priv, body)))
+		req.Header.Set(SignatureKeyHeader, keyID)
+		rec := httptest.NewRecorder()
+		receiver.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if code := send("key_1", oldPriv); code != http.StatusOK {
+		t.Fatalf("expected status 200 for old key, got %d", code)
+	}
+
+	// Rotate keys, and let the client's refresh interval pass.
+	current = []SigningKey{oldKey, newKey}
+	client.signingKeys.mu.Lock()
+	client.signingKeys.attemptedAt = time.Now().Add(-signingKeyRefreshInterval)
+	client.signingKeys.mu.Unlock()
+
+	if code := send("key_2", newPriv); code != http.StatusOK {
+		t.Errorf("expected status 200 for rotated key, got %d", code)
+	}
+	if requests != 2 {
+		t.Errorf("expected one refetch, got %d requests", requests)
+	}
+
+	// Further unknown keys are not refetched within the refresh interval.
+	if code := send("key_3", newPriv); code != http.StatusUnauthorized {
+		t.Errorf("expected status 401 for unknown key, got %d", code)
+	}
+	if requests != 2 {
+		t.Errorf("expected refetches to be rate limited, got %d requests", requests)
+	}
+}
+
+func TestWebhookReceiver_ExpiredSigningKey(t *testing.T) {
+	body := []byte(`{"id":"notif_123","alert_id":"alert_456"}`)
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		want      int
+	}{
+		{"expired", time.Now().Add(-time.Minute), http.StatusUnauthorized},
+		{"not yet expired", time.Now().Add(time.Hour), http.StatusOK},
+		{"no expiry", time.Time{}, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, priv := newEd25519SigningKey(t, "key_1")
+			key.ExpiresAt = tt.expiresAt
+			receiver := NewWebhookReceiver("", func(n *WebhookNotification) error {
+				return nil
+			}, WithSigningKeys(func(ctx context.Context) ([]SigningKey, error) {
+				return []SigningKey{key}, nil
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+			req.Header.Set(SignatureHeader, hex.EncodeToString(ed25519.Sign(priv, body)))
+			req.Header.Set(SignatureKeyHeader, "key_1")
+			rec := httptest.NewRecorder()
+			receiver.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Errorf("expected status %d, got %d", tt.want, rec.Code)
+			}
+		})
+	}
+}
+
+func TestSigningKey_MarshalOmitsZeroExpiry(t *testing.T) {
+	data, err := json.Marshal(SigningKey{ID: "key_1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(data, []byte("expires_at")) {
+		t.Errorf("expected zero expires_at to be omitted, got %s", data)
+	}
+}
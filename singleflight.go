@@ -0,0 +1,71 @@
+package corestream
+
+import (
+	"context"
+	"sync"
+)
+
+// WithSingleflight collapses concurrent identical GET requests into a single
+// upstream request whose response is shared by all callers. Requests are
+// identical when their URLs, including query parameters, match.
+func WithSingleflight() Option {
+	return func(c *Client) error {
+		c.inflight = &inflightGroup{calls: make(map[string]*inflightCall)}
+		return nil
+	}
+}
+
+type inflightCall struct {
+	done    chan struct{}
+	resp    *rawResponse
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// inflightGroup deduplicates concurrent calls with the same key.
+type inflightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*inflightCall
+}
+
+// do runs fn once per key among concurrent callers. The call runs detached
+// from any single caller's context, and each caller stops waiting when its
+// own context is done. The call is cancelled once every caller has given up.
+func (g *inflightGroup) do(ctx context.Context, key string, fn func(context.Context) (*rawResponse, error)) (*rawResponse, error) {
+	g.mu.Lock()
+	call, ok := g.calls[key]
+	if !ok {
+		callCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		call = &inflightCall{done: make(chan struct{}), cancel: cancel}
+		g.calls[key] = call
+		go func() {
+			call.resp, call.err = fn(callCtx)
+			cancel()
+			g.mu.Lock()
+			if g.calls[key] == call {
+				delete(g.calls, key)
+			}
+			g.mu.Unlock()
+			close(call.done)
+		}()
+	}
+	call.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.resp, call.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			call.cancel()
+			if g.calls[key] == call {
+				delete(g.calls, key)
+			}
+		}
+		g.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,154 @@
+package corestream
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithSingleflight(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		w.Write([]byte(`{"id":"streamer_123","login":"teststreamer"}`))
+	})
+	defer server.Close()
+
+	if err := WithSingleflight()(client); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	results := make([]*Streamer, 10)
+	errs := make([]error, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = client.GetStreamer(ctx, "streamer_123")
+		}(i)
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := requests.Load(); n != 1 {
+		t.Errorf("expected 1 upstream request, got %d", n)
+	}
+	for i := range results {
+		if errs[i] != nil {
+			t.Fatalf("unexpected error: %v", errs[i])
+		}
+		if results[i].Login != "teststreamer" {
+			t.Errorf("expected login 'teststreamer', got %s", results[i].Login)
+		}
+	}
+	if results[0] == results[1] {
+		t.Error("callers should receive independent results")
+	}
+}
+
+func TestWithSingleflight_MutatingRequestsNotShared(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer server.Close()
+
+	if err := WithSingleflight()(client); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client.DeleteAlert(context.Background(), "alert_123")
+		}()
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := requests.Load(); n != 3 {
+		t.Errorf("expected 3 upstream requests, got %d", n)
+	}
+}
+
+func TestWithSingleflight_LeaderCancelled(t *testing.T) {
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		w.Write([]byte(`{"id":"streamer_123","login":"teststreamer"}`))
+	})
+	defer server.Close()
+
+	if err := WithSingleflight()(client); err != nil {
+		t.Fatal(err)
+	}
+
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := client.GetStreamer(leaderCtx, "streamer_123")
+		leaderErr <- err
+	}()
+	<-started
+
+	type result struct {
+		streamer *Streamer
+		err      error
+	}
+	follower := make(chan result, 1)
+	go func() {
+		s, err := client.GetStreamer(context.Background(), "streamer_123")
+		follower <- result{s, err}
+	}()
+
+	// Wait for the follower to join the in-flight call before cancelling.
+	for {
+		client.inflight.mu.Lock()
+		var waiters int
+		for _, call := range client.inflight.calls {
+			waiters = call.waiters
+		}
+		client.inflight.mu.Unlock()
+		if waiters == 2 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+	if err := <-leaderErr; err != context.Canceled {
+		t.Errorf("expected leader to fail with context.Canceled, got %v", err)
+	}
+	close(release)
+
+	res := <-follower
+	if res.err != nil {
+		t.Fatalf("unexpected follower error: %v", res.err)
+	}
+	if res.streamer.Login != "teststreamer" {
+		t.Errorf("expected login 'teststreamer', got %s", res.streamer.Login)
+	}
+}
@@ -3,15 +3,156 @@ package corestream
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 )
 
 // GetStreamer retrieves detailed information about a specific streamer.
 func (c *Client) GetStreamer(ctx context.Context, streamerID string) (*Streamer, error) {
-	path := fmt.Sprintf("/v2/streamers/%s", streamerID)
+	return c.getStreamer(ctx, fmt.Sprintf("/v2/streamers/%s", streamerID))
+}
+
+// GetStreamerByLogin retrieves a streamer by Twitch login, e.g. "shroud".
+// Logins are matched case-insensitively.
+func (c *Client) GetStreamerByLogin(ctx context.Context, login string) (*Streamer, error) {
+	if login == "" {
+		return nil, fmt.Errorf("corestream: login is required")
+	}
+	return c.getStreamer(ctx, "/v2/streamers/by-login/"+url.PathEscape(strings.ToLower(login)))
+}
+
+// GetStreamerByTwitchID retrieves a streamer by Twitch user ID.
+func (c *Client) GetStreamerByTwitchID(ctx context.Context, twitchID string) (*Streamer, error) {
+	if twitchID == "" {
+		return nil, fmt.Errorf("corestream: Twitch ID is required")
+	}
+	return c.getStreamer(ctx, "/v2/streamers/by-twitch-id/"+url.PathEscape(twitchID))
+}
+
+func (c *Client) getStreamer(ctx context.Context, path string) (*Streamer, error) {
 	var streamer Streamer
 	if err := c.request(ctx, http.MethodGet, path, nil, nil, &streamer); err != nil {
 		return nil, err
 	}
 	return &streamer, nil
 }
+
+// GetLatestStream retrieves a streamer's current stream if they are live, or
+// their most recent stream otherwise. It returns an *APIError with status 404
+// if the streamer has no recorded streams.
+func (c *Client) GetLatestStream(ctx context.Context, streamerID string) (*Stream, error) {
+	path := fmt.Sprintf("/v2/streamers/%s/streams/latest", streamerID)
+	var resp GetStreamResponse
+	if err := c.request(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Stream, nil
+}
+
+// ListStreamers returns a page of streamers. opts may be nil.
+func (c *Client) ListStreamers(ctx context.Context, opts *ListStreamersOptions) (*ListStreamersResponse, error) {
+	if opts == nil {
+		opts = &ListStreamersOptions{}
+	}
+	return c.listStreamers(ctx, "/v2/streamers", opts)
+}
+
+// ListStreamersPage returns a single page of streamers that can fetch the
+// pages after it. opts may be nil.
+func (c *Client) ListStreamersPage(ctx context.Context, opts *ListStreamersOptions) (*Page[Streamer], error) {
+	startPage, fetch := c.streamersFetcher("/v2/streamers", opts)
+	return fetchPage(ctx, startPage, fetch)
+}
+
+// StreamersIterator returns an iterator over all streamers, fetching pages as
+// needed. opts may be nil.
+func (c *Client) StreamersIterator(ctx context.Context, opts *ListStreamersOptions, popts ...PaginationOption) iter.Seq2[Streamer, error] {
+	startPage, fetch := c.streamersFetcher("/v2/streamers", opts)
+	return paginate(ctx, startPage, fetch, popts...)
+}
+
+// ListTrackedStreamers returns a page of the streamers the account tracks.
+// opts may be nil.
+func (c *Client) ListTrackedStreamers(ctx context.Context, opts *ListStreamersOptions) (*ListStreamersResponse, error) {
+	if opts == nil {
+		opts = &ListStreamersOptions{}
+	}
+	return c.listStreamers(ctx, "/v2/tracked-streamers", opts)
+}
+
+// ListTrackedStreamersPage returns a single page of the streamers the account
+// tracks that can fetch the pages after it. opts may be nil.
+func (c *Client) ListTrackedStreamersPage(ctx context.Context, opts *ListStreamersOptions) (*Page[Streamer], error) {
+	startPage, fetch := c.streamersFetcher("/v2/tracked-streamers", opts)
+	return fetchPage(ctx, startPage, fetch)
+}
+
+// TrackedStreamersIterator returns an iterator over all the streamers the
+// account tracks, fetching pages as needed. opts may be nil.
+func (c *Client) TrackedStreamersIterator(ctx context.Context, opts *ListStreamersOptions, popts ...PaginationOption) iter.Seq2[Streamer, error] {
+	startPage, fetch := c.streamersFetcher("/v2/tracked-streamers", opts)
+	return paginate(ctx, startPage, fetch, popts...)
+}
+
+// TrackStreamer adds a streamer to the account's tracked streamers. Tracking
+// a streamer that is already tracked is not an error.
+func (c *Client) TrackStreamer(ctx context.Context, streamerID string) (*Streamer, error) {
+	if streamerID == "" {
+		return nil, fmt.Errorf("corestream: streamer ID is required")
+	}
+	var streamer Streamer
+	if err := c.request(ctx, http.MethodPost, "/v2/tracked-streamers", nil, &TrackStreamerRequest{StreamerID: streamerID}, &streamer); err != nil {
+		return nil, err
+	}
+	return &streamer, nil
+}
+
+// UntrackStreamer removes a streamer from the account's tracked streamers.
+func (c *Client) UntrackStreamer(ctx context.Context, streamerID string) error {
+	if streamerID == "" {
+		return fmt.Errorf("corestream: streamer ID is required")
+	}
+	path := fmt.Sprintf("/v2/tracked-streamers/%s", streamerID)
+	return c.request(ctx, http.MethodDelete, path, nil, nil, nil)
+}
+
+func (c *Client) streamersFetcher(path string, opts *ListStreamersOptions) (int, pageFetcher[Streamer]) {
+	base := ListStreamersOptions{}
+	if opts != nil {
+		base = *opts
+	}
+	return base.Page, func(ctx context.Context, page int) ([]Streamer, Pagination, error) {
+		o := base
+		o.Page = page
+		resp, err := c.listStreamers(ctx, path, &o)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+		return resp.Streamers, resp.Pagination, nil
+	}
+}
+
+func (c *Client) listStreamers(ctx context.Context, path string, opts *ListStreamersOptions) (*ListStreamersResponse, error) {
+	query := url.Values{}
+	if opts.Page > 0 {
+		query.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.PageSize > 0 {
+		query.Set("page_size", strconv.Itoa(opts.PageSize))
+	}
+	if opts.BroadcasterType != "" {
+		query.Set("broadcaster_type", opts.BroadcasterType)
+	}
+	if opts.MinFollowers > 0 {
+		query.Set("min_followers", strconv.Itoa(opts.MinFollowers))
+	}
+
+	var resp ListStreamersResponse
+	if err := c.request(ctx, http.MethodGet, path, query, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -3,7 +3,9 @@ package corestream
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
+	"strconv"
 	"testing"
 	"time"
 )
@@ -68,3 +70,239 @@ func TestGetStreamer(t *testing.T) {
 		}
 	})
 }
+
+func TestGetLatestStream(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v2/streamers/streamer_xyz/streams/latest" {
+				t.Errorf("expected path /v2/streamers/streamer_xyz/streams/latest, got %s", r.URL.Path)
+			}
+			w.Write([]byte(`{"stream":{"id":"stream_9","streamer_id":"streamer_xyz","is_live":true}}`))
+		})
+		defer server.Close()
+
+		stream, err := client.GetLatestStream(context.Background(), "streamer_xyz")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if stream.ID != "stream_9" || !stream.IsLive {
+			t.Errorf("unexpected stream: %+v", stream)
+		}
+	})
+
+	t.Run("no streams", func(t *testing.T) {
+		client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":"not_found","message":"no streams"}`))
+		})
+		defer server.Close()
+
+		_, err := client.GetLatestStream(context.Background(), "streamer_xyz")
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+			t.Errorf("expected 404 APIError, got %v", err)
+		}
+	})
+}
+
+func TestListStreamers(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/streamers" {
+			t.Errorf("expected path /v2/streamers, got %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("broadcaster_type") != BroadcasterPartner || q.Get("min_followers") != "10000" || q.Get("page_size") != "2" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(ListStreamersResponse{
+			Streamers:  []Streamer{{ID: "streamer_1"}, {ID: "streamer_2"}},
+			Pagination: Pagination{Page: 1, PageSize: 2, TotalItems: 2, TotalPages: 1},
+		})
+	})
+	defer server.Close()
+
+	opts := &ListStreamersOptions{PageSize: 2, BroadcasterType: BroadcasterPartner, MinFollowers: 10000}
+	resp, err := client.ListStreamers(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Streamers) != 2 || resp.Streamers[1].ID != "streamer_2" {
+		t.Errorf("unexpected streamers: %+v", resp.Streamers)
+	}
+}
+
+func TestStreamersIterator(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		json.NewEncoder(w).Encode(ListStreamersResponse{
+			Streamers:  []Streamer{{ID: "streamer_" + strconv.Itoa(page)}},
+			Pagination: Pagination{Page: page, PageSize: 1, TotalItems: 3, TotalPages: 3},
+		})
+	})
+	defer server.Close()
+
+	var ids []string
+	for s, err := range client.StreamersIterator(context.Background(), nil) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ids = append(ids, s.ID)
+	}
+	if len(ids) != 3 || ids[0] != "streamer_1" || ids[2] != "streamer_3" {
+		t.Errorf("expected streamer_1..streamer_3, got %v", ids)
+	}
+}
+
+func TestListStreamersPage(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/streamers" {
+			t.Errorf("expected path /v2/streamers, got %s", r.URL.Path)
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		json.NewEncoder(w).Encode(ListStreamersResponse{
+			Streamers:  []Streamer{{ID: "streamer_" + strconv.Itoa(page)}},
+			Pagination: Pagination{Page: page, PageSize: 1, TotalItems: 2, TotalPages: 2},
+		})
+	})
+	defer server.Close()
+
+	ctx := context.Background()
+	page, err := client.ListStreamersPage(ctx, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].ID != "streamer_1" {
+		t.Errorf("unexpected first page %+v", page.Items)
+	}
+
+	next, err := page.NextPage(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(next.Items) != 1 || next.Items[0].ID != "streamer_2" {
+		t.Errorf("unexpected second page %+v", next.Items)
+	}
+	if next.HasNext() {
+		t.Error("expected no page after the last")
+	}
+}
+
+func TestGetStreamerByLogin(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/streamers/by-login/teststreamer" {
+			t.Errorf("expected path /v2/streamers/by-login/teststreamer, got %s", r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(Streamer{ID: "streamer_xyz", Login: "teststreamer"})
+	})
+	defer server.Close()
+
+	streamer, err := client.GetStreamerByLogin(context.Background(), "TestStreamer")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if streamer.ID != "streamer_xyz" {
+		t.Errorf("expected streamer_xyz, got %s", streamer.ID)
+	}
+
+	if _, err := client.GetStreamerByLogin(context.Background(), ""); err == nil {
+		t.Error("expected error for empty login")
+	}
+}
+
+func TestGetStreamerByTwitchID(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/streamers/by-twitch-id/123456789" {
+			t.Errorf("expected path /v2/streamers/by-twitch-id/123456789, got %s", r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(Streamer{ID: "streamer_xyz", TwitchID: "123456789"})
+	})
+	defer server.Close()
+
+	streamer, err := client.GetStreamerByTwitchID(context.Background(), "123456789")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if streamer.TwitchID != "123456789" {
+		t.Errorf("expected Twitch ID 123456789, got %s", streamer.TwitchID)
+	}
+
+	if _, err := client.GetStreamerByTwitchID(context.Background(), ""); err == nil {
+		t.Error("expected error for empty Twitch ID")
+	}
+}
+
+func TestTrackedStreamers(t *testing.T) {
+	tracked := map[string]bool{"streamer_1": true}
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/tracked-streamers":
+			var streamers []Streamer
+			for id := range tracked {
+				streamers = append(streamers, Streamer{ID: id})
+			}
+			json.NewEncoder(w).Encode(ListStreamersResponse{
+				Streamers:  streamers,
+				Pagination: Pagination{Page: 1, PageSize: 20, TotalItems: len(streamers), TotalPages: 1},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/tracked-streamers":
+			var req TrackStreamerRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Errorf("invalid request body: %v", err)
+			}
+			tracked[req.StreamerID] = true
+			json.NewEncoder(w).Encode(Streamer{ID: req.StreamerID})
+		case r.Method == http.MethodDelete && r.URL.Path == "/v2/tracked-streamers/streamer_1":
+			delete(tracked, "streamer_1")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer server.Close()
+	ctx := context.Background()
+
+	streamer, err := client.TrackStreamer(ctx, "streamer_2")
+	if err != nil {
+		t.Fatalf("TrackStreamer: unexpected error: %v", err)
+	}
+	if streamer.ID != "streamer_2" {
+		t.Errorf("expected streamer_2, got %s", streamer.ID)
+	}
+	if err := client.UntrackStreamer(ctx, "streamer_1"); err != nil {
+		t.Fatalf("UntrackStreamer: unexpected error: %v", err)
+	}
+
+	var ids []string
+	for s, err := range client.TrackedStreamersIterator(ctx, nil) {
+		if err != nil {
+			t.Fatalf("TrackedStreamersIterator: unexpected error: %v", err)
+		}
+		ids = append(ids, s.ID)
+	}
+	if len(ids) != 1 || ids[0] != "streamer_2" {
+		t.Errorf("expected only streamer_2 to be tracked, got %v", ids)
+	}
+
+	resp, err := client.ListTrackedStreamers(ctx, nil)
+	if err != nil {
+		t.Fatalf("ListTrackedStreamers: unexpected error: %v", err)
+	}
+	if len(resp.Streamers) != 1 {
+		t.Errorf("expected 1 tracked streamer, got %d", len(resp.Streamers))
+	}
+
+	page, err := client.ListTrackedStreamersPage(ctx, nil)
+	if err != nil {
+		t.Fatalf("ListTrackedStreamersPage: unexpected error: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].ID != "streamer_2" {
+		t.Errorf("expected only streamer_2 on the page, got %+v", page.Items)
+	}
+	if page.HasNext() {
+		t.Error("expected a single page of tracked streamers")
+	}
+
+	if _, err := client.TrackStreamer(ctx, ""); err == nil {
+		t.Error("expected error for empty streamer ID")
+	}
+}
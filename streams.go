@@ -3,53 +3,256 @@ package corestream
 import (
 	"context"
 	"fmt"
+	"iter"
+	"maps"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // ListStreams returns a paginated list of streams.
 // Use streamerID to filter streams by a specific streamer (optional, pass empty string to skip).
+// New code should use ListStreamsWithOptions.
 func (c *Client) ListStreams(ctx context.Context, page, pageSize int, streamerID string) (*ListStreamsResponse, error) {
-	query := url.Values{}
-	if page > 0 {
-		query.Set("page", strconv.Itoa(page))
+	return c.listStreams(ctx, &ListStreamsOptions{Page: page, PageSize: pageSize, StreamerID: streamerID})
+}
+
+// ListStreamsWithOptions returns a page of streams. opts may be nil.
+func (c *Client) ListStreamsWithOptions(ctx context.Context, opts *ListStreamsOptions) (*ListStreamsResponse, error) {
+	if opts == nil {
+		opts = &ListStreamsOptions{}
+	}
+	return c.listStreams(ctx, opts)
+}
+
+// ListStreamsPage returns a single page of streams that can fetch the pages after it.
+// opts may be nil.
+func (c *Client) ListStreamsPage(ctx context.Context, opts *ListStreamsOptions) (*Page[Stream], error) {
+	startPage, fetch := c.streamsFetcher(opts)
+	return fetchPage(ctx, startPage, fetch)
+}
+
+// StreamsIterator returns an iterator over all streams, fetching pages as needed.
+// opts may be nil.
+func (c *Client) StreamsIterator(ctx context.Context, opts *ListStreamsOptions, popts ...PaginationOption) iter.Seq2[Stream, error] {
+	startPage, fetch := c.streamsFetcher(opts)
+	return paginate(ctx, startPage, fetch, popts...)
+}
+
+func (c *Client) streamsFetcher(opts *ListStreamsOptions) (int, pageFetcher[Stream]) {
+	base := ListStreamsOptions{}
+	if opts != nil {
+		base = *opts
 	}
-	if pageSize > 0 {
-		query.Set("page_size", strconv.Itoa(pageSize))
+	return base.Page, func(ctx context.Context, page int) ([]Stream, Pagination, error) {
+		o := base
+		o.Page = page
+		resp, err := c.listStreams(ctx, &o)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+		return resp.Streams, resp.Pagination, nil
 	}
-	if streamerID != "" {
-		query.Set("streamer_id", streamerID)
+}
+
+// ListAllStreams fetches every page of streams and returns them as a single slice.
+// Use WithMaxItems to cap the number of streams fetched. opts may be nil.
+func (c *Client) ListAllStreams(ctx context.Context, opts *ListStreamsOptions, popts ...PaginationOption) ([]Stream, error) {
+	return collect(c.StreamsIterator(ctx, opts, popts...))
+}
+
+// ListLiveStreams returns a page of streams that are currently live. The
+// started, duration, and VOD filters in opts do not apply and are not sent.
+// opts may be nil.
+func (c *Client) ListLiveStreams(ctx context.Context, opts *ListStreamsOptions) (*ListStreamsResponse, error) {
+	o := ListStreamsOptions{}
+	if opts != nil {
+		o = *opts
 	}
+	o.StartedAfter = time.Time{}
+	o.StartedBefore = time.Time{}
+	o.MinDurationSeconds = 0
+	o.HasVOD = nil
 
 	var resp ListStreamsResponse
-	if err := c.request(ctx, http.MethodGet, "/v2/streams", query, nil, &resp); err != nil {
+	if err := c.request(ctx, http.MethodGet, "/v2/streams/live", streamsQuery(&o), nil, &resp); err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
+func (c *Client) listStreams(ctx context.Context, opts *ListStreamsOptions) (*ListStreamsResponse, error) {
+	var resp ListStreamsResponse
+	if err := c.request(ctx, http.MethodGet, "/v2/streams", streamsQuery(opts), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func streamsQuery(opts *ListStreamsOptions) url.Values {
+	query := url.Values{}
+	if opts.Page > 0 {
+		query.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.PageSize > 0 {
+		query.Set("page_size", strconv.Itoa(opts.PageSize))
+	}
+	if opts.StreamerID != "" {
+		query.Set("streamer_id", opts.StreamerID)
+	}
+	setTime(query, "started_after", opts.StartedAfter)
+	setTime(query, "started_before", opts.StartedBefore)
+	if opts.MinDurationSeconds > 0 {
+		query.Set("min_duration_seconds", strconv.Itoa(opts.MinDurationSeconds))
+	}
+	if opts.HasVOD != nil {
+		query.Set("has_vod", strconv.FormatBool(*opts.HasVOD))
+	}
+	if opts.Category != "" {
+		query.Set("category", opts.Category)
+	}
+	if opts.Language != "" {
+		query.Set("language", opts.Language)
+	}
+	setSort(query, string(opts.SortBy), opts.SortOrder)
+	return query
+}
+
 // SearchStreams searches for streams by keywords or phrases in their transcripts.
 // The query supports individual words and "quoted phrases" for exact matches.
 // timeRange can be "today", "week", or "month" (defaults to "today" if empty).
+// New code should use SearchStreamsWithOptions.
 func (c *Client) SearchStreams(ctx context.Context, query string, page, pageSize int, timeRange string) (*SearchStreamsResponse, error) {
+	return c.searchStreams(ctx, query, &SearchStreamsOptions{Page: page, PageSize: pageSize, TimeRange: timeRange})
+}
+
+// SearchStreamsWithOptions searches for streams by keywords or phrases in their
+// transcripts. The query supports individual words and "quoted phrases" for
+// exact matches. opts may be nil.
+func (c *Client) SearchStreamsWithOptions(ctx context.Context, query string, opts *SearchStreamsOptions) (*SearchStreamsResponse, error) {
+	if opts == nil {
+		opts = &SearchStreamsOptions{}
+	}
+	return c.searchStreams(ctx, query, opts)
+}
+
+// SearchIterator returns an iterator over all results of a stream search,
+// fetching pages as needed. opts may be nil.
+func (c *Client) SearchIterator(ctx context.Context, query string, opts *SearchStreamsOptions, popts ...PaginationOption) iter.Seq2[SearchResult, error] {
+	startPage, fetch := c.searchFetcher(query, opts)
+	return paginate(ctx, startPage, fetch, popts...)
+}
+
+func (c *Client) searchFetcher(query string, opts *SearchStreamsOptions) (int, pageFetcher[SearchResult]) {
+	base := SearchStreamsOptions{}
+	if opts != nil {
+		base = *opts
+	}
+	return base.Page, func(ctx context.Context, page int) ([]SearchResult, Pagination, error) {
+		o := base
+		o.Page = page
+		resp, err := c.searchStreams(ctx, query, &o)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+		return resp.Results, resp.Pagination, nil
+	}
+}
+
+func (c *Client) searchStreams(ctx context.Context, query string, opts *SearchStreamsOptions) (*SearchStreamsResponse, error) {
+	if !opts.From.IsZero() || !opts.To.IsZero() {
+		if opts.TimeRange != "" {
+			return nil, fmt.Errorf("corestream: search TimeRange cannot be combined with From or To")
+		}
+		if !opts.From.IsZero() && !opts.To.IsZero() && !opts.To.After(opts.From) {
+			return nil, fmt.Errorf("corestream: search range ends before it starts")
+		}
+	}
+	if opts.HighlightTag != "" && !validHighlightTag(opts.HighlightTag) {
+		return nil, fmt.Errorf("corestream: invalid highlight tag %q", opts.HighlightTag)
+	}
 	params := url.Values{}
 	params.Set("q", query)
-	if page > 0 {
-		params.Set("page", strconv.Itoa(page))
+	if opts.Page > 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.PageSize > 0 {
+		params.Set("page_size", strconv.Itoa(opts.PageSize))
+	}
+	if opts.Mode != "" {
+		params.Set("mode", string(opts.Mode))
+	}
+	if opts.TimeRange != "" {
+		params.Set("time_range", opts.TimeRange)
+	}
+	setTime(params, "from", opts.From)
+	setTime(params, "to", opts.To)
+	for _, id := range opts.StreamerIDs {
+		params.Add("streamer_id", id)
+	}
+	for _, category := range opts.Categories {
+		params.Add("category", category)
 	}
-	if pageSize > 0 {
-		params.Set("page_size", strconv.Itoa(pageSize))
+	for _, lang := range opts.Languages {
+		params.Add("language", lang)
 	}
-	if timeRange != "" {
-		params.Set("time_range", timeRange)
+	setSort(params, string(opts.SortBy), opts.SortOrder)
+	if opts.HighlightTag != "" {
+		params.Set("highlight_tag", opts.HighlightTag)
+	}
+	if opts.FragmentSize > 0 {
+		params.Set("fragment_size", strconv.Itoa(opts.FragmentSize))
+	}
+	if opts.MaxFragments > 0 {
+		params.Set("max_fragments", strconv.Itoa(opts.MaxFragments))
+	}
+	for _, agg := range opts.Aggregations {
+		params.Add("aggregation", string(agg))
 	}
 
-	var resp SearchStreamsResponse
-	if err := c.request(ctx, http.MethodGet, "/v2/streams/search", params, nil, &resp); err != nil {
+	resp, err := c.fetchSearch(ctx, params)
+	if err != nil || !c.searchAutoCorrect || len(resp.Results) > 0 || len(resp.Suggestions) == 0 {
+		return resp, err
+	}
+	suggestion := resp.Suggestions[0]
+	corrected := maps.Clone(params)
+	corrected.Set("q", suggestion)
+	if resp, err = c.fetchSearch(ctx, corrected); err != nil {
 		return nil, err
 	}
-	return &resp, nil
+	resp.CorrectedQuery = suggestion
+	return resp, nil
+}
+
+func (c *Client) fetchSearch(ctx context.Context, params url.Values) (*SearchStreamsResponse, error) {
+	fetch := func(ctx context.Context) (*SearchStreamsResponse, error) {
+		var resp SearchStreamsResponse
+		if err := c.request(ctx, http.MethodGet, "/v2/streams/search", params, nil, &resp); err != nil {
+			return nil, err
+		}
+		return &resp, nil
+	}
+	if c.searchCache != nil {
+		return c.searchCache.get(ctx, searchCacheKey(params), fetch)
+	}
+	return fetch(ctx)
+}
+
+// SuggestSearchTerms returns completions for a partially typed search query,
+// most popular first.
+func (c *Client) SuggestSearchTerms(ctx context.Context, prefix string) ([]string, error) {
+	if strings.TrimSpace(prefix) == "" {
+		return nil, fmt.Errorf("corestream: search prefix is required")
+	}
+	query := url.Values{}
+	query.Set("prefix", prefix)
+	var resp SearchSuggestionsResponse
+	if err := c.request(ctx, http.MethodGet, "/v2/streams/search/suggestions", query, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Suggestions, nil
 }
 
 // GetStream retrieves detailed information about a specific stream.
@@ -62,12 +265,111 @@ func (c *Client) GetStream(ctx context.Context, streamID string) (*Stream, error
 	return &resp.Stream, nil
 }
 
+// GetStreamStats retrieves the average and peak concurrent viewers and the
+// chat activity of a stream.
+func (c *Client) GetStreamStats(ctx context.Context, streamID string) (*StreamStats, error) {
+	path := fmt.Sprintf("/v2/streams/%s/stats", streamID)
+	var resp GetStreamStatsResponse
+	if err := c.request(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Stats, nil
+}
+
+// GetStreamTopics retrieves the keywords and entities extracted from a
+// stream's transcript, highest ranked first.
+func (c *Client) GetStreamTopics(ctx context.Context, streamID string) (*StreamTopicsResponse, error) {
+	path := fmt.Sprintf("/v2/streams/%s/topics", streamID)
+	var resp StreamTopicsResponse
+	if err := c.request(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListCategories returns the categories known to the API, whose names can be
+// used in Alert.Categories and ListStreamsOptions.Category.
+func (c *Client) ListCategories(ctx context.Context) (*ListCategoriesResponse, error) {
+	var resp ListCategoriesResponse
+	if err := c.request(ctx, http.MethodGet, "/v2/categories", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetStreamChapters retrieves a stream's chapters, which change whenever the
+// streamer changes the stream's title or category.
+func (c *Client) GetStreamChapters(ctx context.Context, streamID string) (*StreamChaptersResponse, error) {
+	path := fmt.Sprintf("/v2/streams/%s/chapters", streamID)
+	var resp StreamChaptersResponse
+	if err := c.request(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // GetStreamTranscript retrieves the full transcript for a specific stream.
 func (c *Client) GetStreamTranscript(ctx context.Context, streamID string) (*TranscriptResponse, error) {
+	return c.getStreamTranscript(ctx, streamID, nil)
+}
+
+// GetStreamTranscriptWithOptions retrieves the full transcript for a specific
+// stream. opts may be nil.
+func (c *Client) GetStreamTranscriptWithOptions(ctx context.Context, streamID string, opts *TranscriptOptions) (*TranscriptResponse, error) {
+	if opts == nil {
+		opts = &TranscriptOptions{}
+	}
+	return c.getStreamTranscript(ctx, streamID, transcriptQuery(opts))
+}
+
+func (c *Client) getStreamTranscript(ctx context.Context, streamID string, query url.Values) (*TranscriptResponse, error) {
 	path := fmt.Sprintf("/v2/streams/%s/transcript", streamID)
 	var resp TranscriptResponse
-	if err := c.request(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+	if err := c.request(ctx, http.MethodGet, path, query, nil, &resp); err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
+
+func transcriptQuery(opts *TranscriptOptions) url.Values {
+	query := url.Values{}
+	if opts.Diarize {
+		query.Set("diarize", "true")
+	}
+	if opts.Translate != "" {
+		query.Set("translate", opts.Translate)
+	}
+	return query
+}
+
+// GetStreamTranscriptRange retrieves the transcript segments overlapping the
+// window [from, to), given as offsets from the start of the stream, e.g. the
+// two minutes around a matched phrase. The window is sent to the API and
+// also applied to the response, so only overlapping segments are returned.
+func (c *Client) GetStreamTranscriptRange(ctx context.Context, streamID string, from, to time.Duration) (*TranscriptResponse, error) {
+	if to <= from {
+		return nil, fmt.Errorf("corestream: transcript range ends before it starts")
+	}
+	path := fmt.Sprintf("/v2/streams/%s/transcript", streamID)
+	query := url.Values{}
+	setOffset(query, "from", from)
+	setOffset(query, "to", to)
+	var resp TranscriptResponse
+	if err := c.request(ctx, http.MethodGet, path, query, nil, &resp); err != nil {
+		return nil, err
+	}
+	resp.Segments = transcriptRange(resp.Segments, from, to)
+	return &resp, nil
+}
+
+// transcriptRange returns the segments overlapping [from, to).
+func transcriptRange(segments []TranscriptSegment, from, to time.Duration) []TranscriptSegment {
+	start, end := from.Seconds(), to.Seconds()
+	var in []TranscriptSegment
+	for _, seg := range segments {
+		if seg.End > start && seg.Start < end {
+			in = append(in, seg)
+		}
+	}
+	return in
+}
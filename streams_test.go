@@ -3,7 +3,11 @@ package corestream
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 )
@@ -225,3 +229,718 @@ func TestGetStreamTranscript(t *testing.T) {
 		t.Errorf("expected first segment text 'Hello everyone!', got %s", result.Segments[0].Text)
 	}
 }
+
+func TestListAllStreams(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("streamer_id") != "streamer_123" {
+			t.Errorf("expected streamer_id=streamer_123, got %s", r.URL.Query().Get("streamer_id"))
+		}
+		page := r.URL.Query().Get("page")
+		resp := ListStreamsResponse{
+			Streams:    []Stream{{ID: "stream_" + page}},
+			Pagination: Pagination{PageSize: 1, TotalItems: 3, TotalPages: 3},
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+	defer server.Close()
+
+	streams, err := client.ListAllStreams(context.Background(), &ListStreamsOptions{StreamerID: "streamer_123"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(streams) != 3 || streams[2].ID != "stream_3" {
+		t.Errorf("unexpected streams %+v", streams)
+	}
+}
+
+func TestListStreamsWithOptions(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("page") != "2" || q.Get("page_size") != "5" || q.Get("streamer_id") != "streamer_123" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(ListStreamsResponse{Streams: []Stream{{ID: "stream_1"}}})
+	})
+	defer server.Close()
+
+	resp, err := client.ListStreamsWithOptions(context.Background(), &ListStreamsOptions{Page: 2, PageSize: 5, StreamerID: "streamer_123"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Streams) != 1 {
+		t.Errorf("expected 1 stream, got %d", len(resp.Streams))
+	}
+}
+
+func TestSearchStreamsWithOptions(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("q") != "gaming" || q.Get("time_range") != TimeRangeMonth || q.Get("page_size") != "5" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(SearchStreamsResponse{Results: []SearchResult{{StreamID: "stream_1"}}})
+	})
+	defer server.Close()
+
+	resp, err := client.SearchStreamsWithOptions(context.Background(), "gaming", &SearchStreamsOptions{PageSize: 5, TimeRange: TimeRangeMonth})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Results) != 1 {
+		t.Errorf("expected 1 result, got %d", len(resp.Results))
+	}
+}
+
+func TestListStreamsWithOptions_Sort(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("sort_by") != "started_at" || q.Get("sort_order") != "desc" {
+			t.Errorf("unexpected sort parameters %s", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(ListStreamsResponse{})
+	})
+	defer server.Close()
+
+	_, err := client.ListStreamsWithOptions(context.Background(), &ListStreamsOptions{SortBy: StreamSortByStartedAt, SortOrder: SortDesc})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestListStreamsWithOptions_StartedRange(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if got := q.Get("started_after"); got != "2024-03-01T00:00:00Z" {
+			t.Errorf("expected started_after=2024-03-01T00:00:00Z, got %s", got)
+		}
+		if got := q.Get("started_before"); got != "2024-03-02T00:00:00Z" {
+			t.Errorf("expected started_before=2024-03-02T00:00:00Z, got %s", got)
+		}
+		json.NewEncoder(w).Encode(ListStreamsResponse{})
+	})
+	defer server.Close()
+
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	_, err := client.ListStreamsWithOptions(context.Background(), &ListStreamsOptions{
+		StartedAfter:  day,
+		StartedBefore: day.AddDate(0, 0, 1),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestListStreamsWithOptions_Filters(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		expected := map[string]string{
+			"min_duration_seconds": "1800",
+			"has_vod":              "true",
+			"category":             "Just Chatting",
+			"language":             "es",
+		}
+		for key, want := range expected {
+			if got := q.Get(key); got != want {
+				t.Errorf("expected %s=%s, got %s", key, want, got)
+			}
+		}
+		json.NewEncoder(w).Encode(ListStreamsResponse{
+			Streams: []Stream{{ID: "stream_1", Category: "Just Chatting", DurationSeconds: 3600}},
+		})
+	})
+	defer server.Close()
+
+	hasVOD := true
+	resp, err := client.ListStreamsWithOptions(context.Background(), &ListStreamsOptions{
+		MinDurationSeconds: 1800,
+		HasVOD:             &hasVOD,
+		Category:           "Just Chatting",
+		Language:           "es",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Streams) != 1 || resp.Streams[0].Category != "Just Chatting" {
+		t.Errorf("unexpected streams: %+v", resp.Streams)
+	}
+}
+
+func TestListStreamsWithOptions_NoFilters(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		for _, key := range []string{"min_duration_seconds", "has_vod", "category", "started_after", "started_before"} {
+			if q.Has(key) {
+				t.Errorf("expected %s not to be sent, got %s", key, q.Get(key))
+			}
+		}
+		json.NewEncoder(w).Encode(ListStreamsResponse{})
+	})
+	defer server.Close()
+
+	if _, err := client.ListStreamsWithOptions(context.Background(), nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestListLiveStreams(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/streams/live" {
+			t.Errorf("expected path /v2/streams/live, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("streamer_id"); got != "streamer_1" {
+			t.Errorf("expected streamer_id=streamer_1, got %s", got)
+		}
+		w.Write([]byte(`{"streams":[
+			{"id":"stream_1","streamer_id":"streamer_1","started_at":"2024-03-01T20:00:00Z","is_live":true}
+		],"pagination":{"page":1,"page_size":20,"total":1}}`))
+	})
+	defer server.Close()
+
+	resp, err := client.ListLiveStreams(context.Background(), &ListStreamsOptions{StreamerID: "streamer_1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Streams) != 1 {
+		t.Fatalf("expected 1 stream, got %d", len(resp.Streams))
+	}
+	if s := resp.Streams[0]; !s.IsLive || s.EndedAt != nil {
+		t.Errorf("expected a live stream without EndedAt, got %+v", s)
+	}
+}
+
+func TestListLiveStreams_Query(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if want := "category=Valorant&page_size=5&streamer_id=streamer_1"; r.URL.RawQuery != want {
+			t.Errorf("expected query %s, got %s", want, r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(ListStreamsResponse{})
+	})
+	defer server.Close()
+
+	hasVOD := true
+	opts := &ListStreamsOptions{
+		PageSize:           5,
+		StreamerID:         "streamer_1",
+		Category:           "Valorant",
+		StartedAfter:       time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		StartedBefore:      time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC),
+		MinDurationSeconds: 600,
+		HasVOD:             &hasVOD,
+	}
+	if _, err := client.ListLiveStreams(context.Background(), opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.HasVOD == nil || opts.MinDurationSeconds != 600 {
+		t.Error("caller's options should not be modified")
+	}
+}
+
+func TestGetStream_Ended(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"stream":{"id":"stream_1","started_at":"2024-03-01T20:00:00Z","is_live":false,"ended_at":"2024-03-01T23:00:00Z"}}`))
+	})
+	defer server.Close()
+
+	stream, err := client.GetStream(context.Background(), "stream_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stream.IsLive {
+		t.Error("expected stream not to be live")
+	}
+	want := time.Date(2024, 3, 1, 23, 0, 0, 0, time.UTC)
+	if stream.EndedAt == nil || !stream.EndedAt.Equal(want) {
+		t.Errorf("expected EndedAt %v, got %v", want, stream.EndedAt)
+	}
+}
+
+func TestGetStreamTranscriptRange(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("from") != "60" || q.Get("to") != "180.5" {
+			t.Errorf("unexpected range parameters %s", r.URL.RawQuery)
+		}
+		// Respond with the full transcript, as an API ignoring the range would.
+		json.NewEncoder(w).Encode(TranscriptResponse{Segments: []TranscriptSegment{
+			{Start: 0, End: 30, Text: "before"},
+			{Start: 50, End: 70, Text: "overlaps start"},
+			{Start: 100, End: 110, Text: "inside"},
+			{Start: 180, End: 200, Text: "overlaps end"},
+			{Start: 180.5, End: 190, Text: "after"},
+		}})
+	})
+	defer server.Close()
+
+	resp, err := client.GetStreamTranscriptRange(context.Background(), "stream_1", time.Minute, 180*time.Second+500*time.Millisecond)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var got []string
+	for _, seg := range resp.Segments {
+		got = append(got, seg.Text)
+	}
+	want := []string{"overlaps start", "inside", "overlaps end"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestGetStreamTranscriptRange_Invalid(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request should be made for an invalid range")
+	})
+	defer server.Close()
+
+	if _, err := client.GetStreamTranscriptRange(context.Background(), "stream_1", time.Minute, time.Minute); err == nil {
+		t.Error("expected an error for an empty range")
+	}
+}
+
+func TestGetStreamTranscriptWithOptions_Diarize(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("diarize"); got != "true" {
+			t.Errorf("expected diarize=true, got %q", got)
+		}
+		w.Write([]byte(`{"segments":[
+			{"start":0,"end":2,"text":"welcome back","speaker":"SPEAKER_1"},
+			{"start":2,"end":4,"text":"thanks for having me","speaker":"SPEAKER_2"}
+		]}`))
+	})
+	defer server.Close()
+
+	resp, err := client.GetStreamTranscriptWithOptions(context.Background(), "stream_1", &TranscriptOptions{Diarize: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Segments) != 2 || resp.Segments[0].Speaker != "SPEAKER_1" || resp.Segments[1].Speaker != "SPEAKER_2" {
+		t.Errorf("unexpected segments: %+v", resp.Segments)
+	}
+}
+
+func TestGetStreamTranscriptWithOptions_Nil(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("expected no query parameters, got %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"segments":[]}`))
+	})
+	defer server.Close()
+
+	if _, err := client.GetStreamTranscriptWithOptions(context.Background(), "stream_1", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestGetStreamTranscriptWithOptions_Translate(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("translate"); got != "en" {
+			t.Errorf("expected translate=en, got %q", got)
+		}
+		w.Write([]byte(`{"language":"en","segments":[{"start":0,"end":2,"text":"hello everyone"}]}`))
+	})
+	defer server.Close()
+
+	resp, err := client.GetStreamTranscriptWithOptions(context.Background(), "stream_1", &TranscriptOptions{Translate: "en"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Language != "en" {
+		t.Errorf("expected language en, got %q", resp.Language)
+	}
+	if len(resp.Segments) != 1 || resp.Segments[0].Text != "hello everyone" {
+		t.Errorf("unexpected segments: %+v", resp.Segments)
+	}
+}
+
+func TestGetStreamChapters(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/streams/stream_1/chapters" {
+			t.Errorf("expected path /v2/streams/stream_1/chapters, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"chapters":[
+			{"title":"Morning chat","category":"Just Chatting","start":0,"end":3600},
+			{"title":"Ranked grind","category":"Valorant","start":3600}
+		]}`))
+	})
+	defer server.Close()
+
+	resp, err := client.GetStreamChapters(context.Background(), "stream_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Chapters) != 2 {
+		t.Fatalf("expected 2 chapters, got %d", len(resp.Chapters))
+	}
+
+	tests := []struct {
+		offset time.Duration
+		want   string
+	}{
+		{0, "Just Chatting"},
+		{59 * time.Minute, "Just Chatting"},
+		{time.Hour, "Valorant"},
+		{5 * time.Hour, "Valorant"},
+	}
+	for _, tt := range tests {
+		ch := resp.At(tt.offset)
+		if ch == nil || ch.Category != tt.want {
+			t.Errorf("At(%v): expected %s, got %+v", tt.offset, tt.want, ch)
+		}
+	}
+	if ch := (&StreamChaptersResponse{}).At(time.Minute); ch != nil {
+		t.Errorf("expected no chapter, got %+v", ch)
+	}
+}
+
+func TestListCategories(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/categories" {
+			t.Errorf("expected path /v2/categories, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"categories":[
+			{"id":"509658","name":"Just Chatting","stream_count":1200},
+			{"id":"516575","name":"Valorant","stream_count":340}
+		]}`))
+	})
+	defer server.Close()
+
+	resp, err := client.ListCategories(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Categories) != 2 || resp.Categories[1].Name != "Valorant" || resp.Categories[0].StreamCount != 1200 {
+		t.Errorf("unexpected categories: %+v", resp.Categories)
+	}
+}
+
+func TestGetStream_Category(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"stream":{"id":"stream_1","category":"Valorant","category_id":"516575","game_name":"Valorant"}}`))
+	})
+	defer server.Close()
+
+	stream, err := client.GetStream(context.Background(), "stream_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stream.Category != "Valorant" || stream.CategoryID != "516575" || stream.GameName != "Valorant" {
+		t.Errorf("unexpected category fields: %+v", stream)
+	}
+}
+
+func TestGetStreamStats(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/streams/stream_1/stats" {
+			t.Errorf("expected path /v2/streams/stream_1/stats, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"stats":{"stream_id":"stream_1","average_viewers":812.5,"peak_viewers":1540,"chat_messages":9120,"unique_chatters":640,"chat_messages_per_minute":42.2}}`))
+	})
+	defer server.Close()
+
+	stats, err := client.GetStreamStats(context.Background(), "stream_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stats.AverageViewers != 812.5 || stats.PeakViewers != 1540 {
+		t.Errorf("unexpected viewer stats: %+v", stats)
+	}
+	if stats.ChatMessages != 9120 || stats.UniqueChatters != 640 || stats.ChatMessagesPerMinute != 42.2 {
+		t.Errorf("unexpected chat stats: %+v", stats)
+	}
+}
+
+func TestGetStreamTopics(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/streams/stream_1/topics" {
+			t.Errorf("expected path /v2/streams/stream_1/topics, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"topics":[
+			{"name":"Patch 14.2","kind":"entity","score":0.91,"mentions":12},
+			{"name":"ranked","kind":"keyword","score":0.64,"mentions":30}
+		]}`))
+	})
+	defer server.Close()
+
+	resp, err := client.GetStreamTopics(context.Background(), "stream_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Topics) != 2 {
+		t.Fatalf("expected 2 topics, got %d", len(resp.Topics))
+	}
+	if resp.Topics[0].Kind != TopicEntity || resp.Topics[0].Score != 0.91 || resp.Topics[1].Mentions != 30 {
+		t.Errorf("unexpected topics: %+v", resp.Topics)
+	}
+}
+
+func TestSearchStreamsWithOptions_AbsoluteRange(t *testing.T) {
+	from := time.Date(2024, 3, 1, 14, 0, 0, 0, time.UTC)
+	to := from.Add(90 * time.Minute)
+
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("from") != "2024-03-01T14:00:00Z" || q.Get("to") != "2024-03-01T15:30:00Z" {
+			t.Errorf("unexpected range %s", r.URL.RawQuery)
+		}
+		if q.Has("time_range") {
+			t.Errorf("time_range should not be sent, got %s", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(SearchStreamsResponse{})
+	})
+	defer server.Close()
+
+	if _, err := client.SearchStreamsWithOptions(context.Background(), "outage", &SearchStreamsOptions{From: from, To: to}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := client.SearchStreamsWithOptions(context.Background(), "outage", &SearchStreamsOptions{From: to, To: from}); err == nil {
+		t.Error("expected error for reversed range")
+	}
+	if _, err := client.SearchStreamsWithOptions(context.Background(), "outage", &SearchStreamsOptions{From: from, TimeRange: TimeRangeWeek}); err == nil {
+		t.Error("expected error combining From with TimeRange")
+	}
+}
+
+func TestSearchStreamsWithOptions_StreamerIDs(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		ids := r.URL.Query()["streamer_id"]
+		if len(ids) != 2 || ids[0] != "streamer_a" || ids[1] != "streamer_b" {
+			t.Errorf("unexpected streamer_id values %v", ids)
+		}
+		json.NewEncoder(w).Encode(SearchStreamsResponse{})
+	})
+	defer server.Close()
+
+	opts := &SearchStreamsOptions{StreamerIDs: []string{"streamer_a", "streamer_b"}}
+	if _, err := client.SearchStreamsWithOptions(context.Background(), "sponsor", opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestSearchStreamsWithOptions_CategoriesAndLanguages(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if got := q["category"]; len(got) != 2 || got[0] != "Valorant" || got[1] != "Just Chatting" {
+			t.Errorf("unexpected category values %v", got)
+		}
+		if got := q["language"]; len(got) != 1 || got[0] != "en" {
+			t.Errorf("unexpected language values %v", got)
+		}
+		json.NewEncoder(w).Encode(SearchStreamsResponse{})
+	})
+	defer server.Close()
+
+	opts := &SearchStreamsOptions{Categories: []string{"Valorant", "Just Chatting"}, Languages: []string{"en"}}
+	if _, err := client.SearchStreamsWithOptions(context.Background(), "gg", opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestSearchStreamsWithOptions_Sort(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("sort_by") != "date" || q.Get("sort_order") != "asc" {
+			t.Errorf("unexpected sort parameters %s", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(SearchStreamsResponse{})
+	})
+	defer server.Close()
+
+	opts := &SearchStreamsOptions{SortBy: SearchSortByDate, SortOrder: SortAsc}
+	if _, err := client.SearchStreamsWithOptions(context.Background(), "launch", opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestSearchStreamsWithOptions_Mode(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("mode") != "semantic" || q.Get("q") != "streamer complains about matchmaking" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(SearchStreamsResponse{})
+	})
+	defer server.Close()
+
+	opts := &SearchStreamsOptions{Mode: SearchSemantic}
+	if _, err := client.SearchStreamsWithOptions(context.Background(), "streamer complains about matchmaking", opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestSearchResult_Matches(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"results":[{"stream_id":"stream_1","highlights":["the <em>new patch</em>"],
+			"matches":[{"highlight":"the <em>new patch</em>","match_start":3723.5}]}]}`))
+	})
+	defer server.Close()
+
+	resp, err := client.SearchStreamsWithOptions(context.Background(), "new patch", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	matches := resp.Results[0].Matches
+	if len(matches) != 1 || matches[0].MatchStart != 3723.5 {
+		t.Fatalf("unexpected matches: %+v", matches)
+	}
+	if got, want := matches[0].Offset(), time.Hour+2*time.Minute+3500*time.Millisecond; got != want {
+		t.Errorf("Offset() = %v, want %v", got, want)
+	}
+	stream := &Stream{VodID: "123"}
+	if got, want := stream.VODLinkAt(matches[0].Offset()), "https://twitch.tv/videos/123?t=1h2m3s"; got != want {
+		t.Errorf("VODLinkAt() = %q, want %q", got, want)
+	}
+}
+
+func TestSearchStreamsWithOptions_Aggregations(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query()["aggregation"]; len(got) != 2 || got[0] != "streamer" || got[1] != "day" {
+			t.Errorf("unexpected aggregation values %v", got)
+		}
+		w.Write([]byte(`{"results":[],"aggregations":{
+			"streamer":[{"key":"streamer_a","count":12},{"key":"streamer_b","count":4}],
+			"day":[{"key":"2024-03-01","count":16}]
+		}}`))
+	})
+	defer server.Close()
+
+	opts := &SearchStreamsOptions{Aggregations: []SearchAggregation{AggregateByStreamer, AggregateByDay}}
+	resp, err := client.SearchStreamsWithOptions(context.Background(), "outage", opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	streamers := resp.Aggregations[AggregateByStreamer]
+	if len(streamers) != 2 || streamers[0].Key != "streamer_a" || streamers[0].Count != 12 {
+		t.Errorf("unexpected streamer buckets: %+v", streamers)
+	}
+	if days := resp.Aggregations[AggregateByDay]; len(days) != 1 || days[0].Count != 16 {
+		t.Errorf("unexpected day buckets: %+v", days)
+	}
+}
+
+func TestSuggestSearchTerms(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/streams/search/suggestions" {
+			t.Errorf("expected path /v2/streams/search/suggestions, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("prefix"); got != "valo" {
+			t.Errorf("expected prefix=valo, got %q", got)
+		}
+		w.Write([]byte(`{"suggestions":["valorant","valorant champions"]}`))
+	})
+	defer server.Close()
+
+	suggestions, err := client.SuggestSearchTerms(context.Background(), "valo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(suggestions) != 2 || suggestions[0] != "valorant" {
+		t.Errorf("unexpected suggestions: %v", suggestions)
+	}
+
+	if _, err := client.SuggestSearchTerms(context.Background(), "  "); err == nil {
+		t.Error("expected error for empty prefix")
+	}
+}
+
+func TestSearchIterator(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("q"); got != "acme" {
+			t.Errorf("expected q=acme, got %q", got)
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		json.NewEncoder(w).Encode(SearchStreamsResponse{
+			Results:    []SearchResult{{StreamID: "stream_" + strconv.Itoa(page)}},
+			Pagination: Pagination{Page: page, PageSize: 1, TotalItems: 3, TotalPages: 3},
+		})
+	})
+	defer server.Close()
+
+	var ids []string
+	for r, err := range client.SearchIterator(context.Background(), "acme", nil) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ids = append(ids, r.StreamID)
+	}
+	if len(ids) != 3 || ids[0] != "stream_1" || ids[2] != "stream_3" {
+		t.Errorf("expected stream_1..stream_3, got %v", ids)
+	}
+}
+
+func TestSearchIterator_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		json.NewEncoder(w).Encode(SearchStreamsResponse{
+			Results:    []SearchResult{{StreamID: "stream_" + strconv.Itoa(page)}},
+			Pagination: Pagination{Page: page, PageSize: 1, TotalItems: 3, TotalPages: 3},
+		})
+	})
+	defer server.Close()
+
+	var count int
+	var lastErr error
+	for _, err := range client.SearchIterator(ctx, "acme", nil) {
+		if err != nil {
+			lastErr = err
+			break
+		}
+		count++
+		cancel()
+	}
+	if count != 1 || !errors.Is(lastErr, context.Canceled) {
+		t.Errorf("expected 1 result then context.Canceled, got %d results and %v", count, lastErr)
+	}
+}
+
+func TestSearchStreams_Suggestions(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		switch q := r.URL.Query().Get("q"); q {
+		case "valorent":
+			if r.URL.Query().Get("time_range") != TimeRangeWeek {
+				t.Errorf("expected filters to be kept, got %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"results":[],"suggestions":["valorant","valor"]}`))
+		case "valorant":
+			if r.URL.Query().Get("time_range") != TimeRangeWeek {
+				t.Errorf("expected filters to be kept, got %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"results":[{"stream_id":"stream_1"}]}`))
+		default:
+			t.Errorf("unexpected query %q", q)
+		}
+	}
+	opts := &SearchStreamsOptions{TimeRange: TimeRangeWeek}
+
+	t.Run("without auto-correct", func(t *testing.T) {
+		client, server := setupTestServer(t, handler)
+		defer server.Close()
+
+		resp, err := client.SearchStreamsWithOptions(context.Background(), "valorent", opts)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(resp.Results) != 0 || len(resp.Suggestions) != 2 || resp.CorrectedQuery != "" {
+			t.Errorf("unexpected response: %+v", resp)
+		}
+	})
+
+	t.Run("with auto-correct", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(handler))
+		defer server.Close()
+		client, err := NewClient("test-token", WithBaseURL(server.URL), WithSearchAutoCorrect())
+		if err != nil {
+			t.Fatalf("failed to create client: %v", err)
+		}
+
+		resp, err := client.SearchStreamsWithOptions(context.Background(), "valorent", opts)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(resp.Results) != 1 || resp.CorrectedQuery != "valorant" {
+			t.Errorf("unexpected response: %+v", resp)
+		}
+	})
+}
@@ -0,0 +1,200 @@
+package corestream
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// defaultExcerptWindow is how much transcript is included on each side of a match.
+const defaultExcerptWindow = 30 * time.Second
+
+// TimelineOptions configures BuildIncidentTimeline.
+type TimelineOptions struct {
+	// From and To bound the incident window. A zero value leaves that side open.
+	From time.Time
+	To   time.Time
+
+	// IncludeTranscripts fetches transcript excerpts around each match.
+	IncludeTranscripts bool
+
+	// ExcerptWindow is how much transcript to include before and after each
+	// match. Defaults to 30 seconds.
+	ExcerptWindow time.Duration
+
+	// MaxNotifications caps how many notifications are scanned. Zero means no limit.
+	MaxNotifications int
+}
+
+// Timeline is a chronological report of an alert's activity during an incident window.
+type Timeline struct {
+	Alert   Alert           `json:"alert"`
+	From    time.Time       `json:"from,omitzero"`
+	To      time.Time       `json:"to,omitzero"`
+	Entries []TimelineEntry `json:"entries"`
+}
+
+// TimelineEntry is a single notification in a timeline, enriched with the
+// stream it occurred on and the surrounding transcript.
+type TimelineEntry struct {
+	Time         time.Time           `json:"time"`
+	Notification Notification        `json:"notification"`
+	Stream       *Stream             `json:"stream,omitempty"`
+	Excerpt      []TranscriptSegment `json:"excerpt,omitempty"`
+}
+
+// BuildIncidentTimeline assembles a chronological timeline of an alert's
+// notifications within a time window, together with stream metadata and,
+// optionally, transcript excerpts around each match. opts may be nil.
+func (c *Client) BuildIncidentTimeline(ctx context.Context, alertID string, opts *TimelineOptions) (*Timeline, error) {
+	o := TimelineOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.ExcerptWindow <= 0 {
+		o.ExcerptWindow = defaultExcerptWindow
+	}
+	if !o.From.IsZero() && !o.To.IsZero() && o.To.Before(o.From) {
+		return nil, fmt.Errorf("corestream: timeline window ends before it starts")
+	}
+
+	alert, err := c.GetAlert(ctx, alertID)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := &Timeline{Alert: *alert, From: o.From, To: o.To, Entries: []TimelineEntry{}}
+
+	var popts []PaginationOption
+	if o.MaxNotifications > 0 {
+		popts = append(popts, WithMaxItems(o.MaxNotifications))
+	}
+	nopts := &ListNotificationsOptions{Since: o.From, Until: o.To}
+	for n, err := range c.NotificationsIterator(ctx, alertID, nopts, popts...) {
+		if err != nil {
+			return nil, err
+		}
+		if !o.From.IsZero() && n.Timestamp.Before(o.From) {
+			continue
+		}
+		if !o.To.IsZero() && n.Timestamp.After(o.To) {
+			continue
+		}
+		timeline.Entries = append(timeline.Entries, TimelineEntry{Time: n.Timestamp, Notification: n})
+	}
+
+	sort.SliceStable(timeline.Entries, func(i, j int) bool {
+		return timeline.Entries[i].Time.Before(timeline.Entries[j].Time)
+	})
+
+	streams := make(map[string]*Stream)
+	transcripts := make(map[string]*TranscriptResponse)
+	for i := range timeline.Entries {
+		entry := &timeline.Entries[i]
+		streamID := entry.Notification.StreamID
+		if streamID == "" {
+			continue
+		}
+
+		stream, ok := streams[streamID]
+		if !ok {
+			stream, err = c.GetStream(ctx, streamID)
+			if err != nil && !IsNotFound(err) {
+				return nil, err
+			}
+			streams[streamID] = stream
+		}
+		entry.Stream = stream
+
+		if !o.IncludeTranscripts || stream == nil {
+			continue
+		}
+		transcript, ok := transcripts[streamID]
+		if !ok {
+			transcript, err = c.GetStreamTranscript(ctx, streamID)
+			if err != nil && !IsNotFound(err) {
+				return nil, err
+			}
+			transcripts[streamID] = transcript
+		}
+		if transcript != nil {
+			offset := entry.Time.Sub(stream.StartedAt)
+			entry.Excerpt = transcriptExcerpt(transcript.Segments, offset, o.ExcerptWindow)
+		}
+	}
+
+	return timeline, nil
+}
+
+// transcriptExcerpt returns the segments overlapping offset±window.
+func transcriptExcerpt(segments []TranscriptSegment, offset, window time.Duration) []TranscriptSegment {
+	from := (offset - window).Seconds()
+	to := (offset + window).Seconds()
+	var excerpt []TranscriptSegment
+	for _, seg := range segments {
+		if seg.End >= from && seg.Start <= to {
+			excerpt = append(excerpt, seg)
+		}
+	}
+	return excerpt
+}
+
+// Markdown renders the timeline as a Markdown report.
+func (t *Timeline) Markdown() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Incident timeline: %s\n\n", t.Alert.Name)
+	fmt.Fprintf(&b, "- **Alert ID:** %s\n", t.Alert.ID)
+	if len(t.Alert.Phrases) > 0 {
+		fmt.Fprintf(&b, "- **Phrases:** %s\n", strings.Join(t.Alert.Phrases, ", "))
+	}
+	if !t.From.IsZero() || !t.To.IsZero() {
+		fmt.Fprintf(&b, "- **Window:** %s – %s\n", formatTimelineTime(t.From), formatTimelineTime(t.To))
+	}
+	fmt.Fprintf(&b, "- **Mentions:** %d\n", len(t.Entries))
+
+	for _, e := range t.Entries {
+		fmt.Fprintf(&b, "\n## %s — \"%s\"\n\n", e.Time.UTC().Format(time.RFC3339), e.Notification.MatchedPhrase)
+
+		title := e.Notification.StreamTitle
+		if e.Stream != nil && e.Stream.Title != "" {
+			title = e.Stream.Title
+		}
+		if title != "" {
+			fmt.Fprintf(&b, "- **Stream:** %s\n", title)
+		}
+		if e.Notification.StreamSource != "" {
+			fmt.Fprintf(&b, "- **Source:** %s\n", e.Notification.StreamSource)
+		}
+		if e.Stream != nil && e.Stream.VodURL != "" {
+			fmt.Fprintf(&b, "- **VOD:** %s\n", e.Stream.VodURL)
+		}
+		if e.Notification.Context != "" {
+			fmt.Fprintf(&b, "\n> %s\n", e.Notification.Context)
+		}
+		if len(e.Excerpt) > 0 {
+			b.WriteString("\n```\n")
+			for _, seg := range e.Excerpt {
+				fmt.Fprintf(&b, "[%s] %s\n", formatOffset(seg.Start), seg.Text)
+			}
+			b.WriteString("```\n")
+		}
+	}
+
+	return b.String()
+}
+
+func formatTimelineTime(t time.Time) string {
+	if t.IsZero() {
+		return "…"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// formatOffset formats a transcript offset in seconds as H:MM:SS.
+func formatOffset(seconds float64) string {
+	d := time.Duration(seconds) * time.Second
+	return fmt.Sprintf("%d:%02d:%02d", int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60)
+}
@@ -0,0 +1,87 @@
+package corestream
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuildIncidentTimeline(t *testing.T) {
+	streamStart := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/alerts/alert_123":
+			json.NewEncoder(w).Encode(Alert{ID: "alert_123", Name: "Brand Mentions", Phrases: []string{"acme"}})
+		case "/v2/alerts/alert_123/notifications":
+			json.NewEncoder(w).Encode(ListNotificationsResponse{
+				Notifications: []Notification{
+					{ID: "notif_3", StreamID: "stream_1", MatchedPhrase: "acme", Timestamp: streamStart.Add(50 * time.Minute)},
+					{ID: "notif_2", StreamID: "stream_1", MatchedPhrase: "acme", Timestamp: streamStart.Add(2 * time.Minute), Context: "I love acme"},
+					{ID: "notif_1", StreamID: "stream_1", MatchedPhrase: "acme", Timestamp: streamStart.Add(-time.Hour)},
+				},
+				Pagination: Pagination{Page: 1, PageSize: 20, TotalItems: 3, TotalPages: 1},
+			})
+		case "/v2/streams/stream_1":
+			json.NewEncoder(w).Encode(GetStreamResponse{Stream: Stream{ID: "stream_1", Title: "Morning stream", StartedAt: streamStart}})
+		case "/v2/streams/stream_1/transcript":
+			json.NewEncoder(w).Encode(TranscriptResponse{Segments: []TranscriptSegment{
+				{Start: 0, End: 10, Text: "good morning"},
+				{Start: 100, End: 110, Text: "before the match"},
+				{Start: 118, End: 125, Text: "I love acme"},
+				{Start: 300, End: 310, Text: "much later"},
+				{Start: 3000, End: 3010, Text: "acme again"},
+			}})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	timeline, err := client.BuildIncidentTimeline(context.Background(), "alert_123", &TimelineOptions{
+		From:               streamStart,
+		To:                 streamStart.Add(time.Hour),
+		IncludeTranscripts: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(timeline.Entries) != 2 {
+		t.Fatalf("expected 2 entries in window, got %d", len(timeline.Entries))
+	}
+	if timeline.Entries[0].Notification.ID != "notif_2" || timeline.Entries[1].Notification.ID != "notif_3" {
+		t.Errorf("expected entries in chronological order, got %s, %s", timeline.Entries[0].Notification.ID, timeline.Entries[1].Notification.ID)
+	}
+	if timeline.Entries[0].Stream == nil || timeline.Entries[0].Stream.Title != "Morning stream" {
+		t.Errorf("expected stream metadata, got %+v", timeline.Entries[0].Stream)
+	}
+
+	excerpt := timeline.Entries[0].Excerpt
+	if len(excerpt) != 2 || excerpt[0].Text != "before the match" || excerpt[1].Text != "I love acme" {
+		t.Errorf("unexpected excerpt %+v", excerpt)
+	}
+
+	md := timeline.Markdown()
+	for _, want := range []string{"# Incident timeline: Brand Mentions", "**Mentions:** 2", "Morning stream", "> I love acme", "[0:01:58] I love acme"} {
+		if !strings.Contains(md, want) {
+			t.Errorf("expected markdown to contain %q:\n%s", want, md)
+		}
+	}
+}
+
+func TestBuildIncidentTimeline_InvalidWindow(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected")
+	})
+	defer server.Close()
+
+	now := time.Now()
+	_, err := client.BuildIncidentTimeline(context.Background(), "alert_123", &TimelineOptions{From: now, To: now.Add(-time.Hour)})
+	if err == nil {
+		t.Fatal("expected error for inverted window")
+	}
+}
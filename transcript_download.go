@@ -0,0 +1,191 @@
+package corestream
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// TranscriptFormat is the output format for DownloadTranscript.
+type TranscriptFormat string
+
+// Transcript formats.
+const (
+	// TranscriptJSONL writes one JSON-encoded TranscriptSegment per line.
+	TranscriptJSONL TranscriptFormat = "jsonl"
+	// TranscriptSRT writes SubRip subtitles like WriteSRT, but numbers cues
+	// by segment position so that resumed downloads continue the sequence.
+	TranscriptSRT TranscriptFormat = "srt"
+	// TranscriptText writes a "[H:MM:SS] text" line for each segment with
+	// text, prefixed with the speaker when known.
+	TranscriptText TranscriptFormat = "text"
+)
+
+// downloadFlushSegments is how many segments DownloadTranscript buffers
+// between flushes to w.
+const downloadFlushSegments = 64
+
+// DownloadProgress reports how far a DownloadTranscript call has got.
+type DownloadProgress struct {
+	// Segments is the number of segments flushed to w, counting from the
+	// start of the transcript and including any skipped with Resume.
+	Segments int
+	// BytesWritten is the number of bytes written to w by this call so far,
+	// after compression.
+	BytesWritten int64
+}
+
+// DownloadTranscriptOptions configures DownloadTranscript.
+type DownloadTranscriptOptions struct {
+	TranscriptOptions
+
+	// Format defaults to TranscriptJSONL.
+	Format TranscriptFormat
+
+	// Gzip compresses the output. Resumed downloads are written as a new
+	// gzip member, so appending them to the earlier output yields a valid
+	// gzip file.
+	Gzip bool
+
+	// Resume skips the first Resume segments, continuing a download that
+	// failed after writing them; use DownloadProgress.Segments from the
+	// failed attempt.
+	Resume int
+
+	// Progress, if set, is called each time buffered segments have been
+	// flushed to w, which happens every 64 segments and when the download
+	// ends. Its Segments is always a safe Resume point.
+	Progress func(DownloadProgress)
+}
+
+// DownloadTranscript writes the transcript for a stream to w in the chosen
+// format, decoding it incrementally so that long transcripts are never held
+// in memory. If the download fails part way through, the segments written so
+// far are flushed to w before the error is returned, so the download can be
+// continued with Resume. opts may be nil.
+func (c *Client) DownloadTranscript(ctx context.Context, streamID string, w io.Writer, opts *DownloadTranscriptOptions) (err error) {
+	o := DownloadTranscriptOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Resume < 0 {
+		return errors.New("corestream: transcript resume offset cannot be negative")
+	}
+	switch o.Format {
+	case "", TranscriptJSONL, TranscriptSRT, TranscriptText:
+	default:
+		return fmt.Errorf("corestream: unknown transcript format %q", o.Format)
+	}
+
+	segments, err := c.streamTranscriptSegments(ctx, streamID, transcriptQuery(&o.TranscriptOptions))
+	if err != nil {
+		return err
+	}
+
+	cw := &countingWriter{w: w}
+	out := io.Writer(cw)
+	var gz *gzip.Writer
+	if o.Gzip {
+		gz = gzip.NewWriter(cw)
+		out = gz
+	}
+	bw := bufio.NewWriter(out)
+
+	var write func(n int, seg TranscriptSegment) error
+	switch o.Format {
+	case TranscriptSRT:
+		write = func(n int, seg TranscriptSegment) error {
+			_, err := writeSRTCue(bw, n, seg)
+			return err
+		}
+	case TranscriptText:
+		write = func(_ int, seg TranscriptSegment) error {
+			return writeTranscriptLine(bw, seg)
+		}
+	default:
+		enc := json.NewEncoder(bw)
+		write = func(_ int, seg TranscriptSegment) error {
+			return enc.Encode(seg)
+		}
+	}
+
+	// written is the number of segments in the buffers, and flushed the
+	// number known to have reached w.
+	written, flushed := o.Resume, o.Resume
+	report := func() {
+		if o.Progress != nil && flushed < written {
+			o.Progress(DownloadProgress{Segments: written, BytesWritten: cw.n})
+		}
+		flushed = written
+	}
+	defer func() {
+		ferr := bw.Flush()
+		if gz != nil {
+			ferr = errors.Join(ferr, gz.Close())
+		}
+		if ferr == nil {
+			report()
+		}
+		if err == nil {
+			err = ferr
+		}
+	}()
+
+	n := 0
+	for seg, err := range segments {
+		if err != nil {
+			return err
+		}
+		n++
+		if n <= o.Resume {
+			continue
+		}
+		if err := write(n, seg); err != nil {
+			return err
+		}
+		written = n
+		if written-flushed >= downloadFlushSegments {
+			if err := bw.Flush(); err != nil {
+				return err
+			}
+			if gz != nil {
+				if err := gz.Flush(); err != nil {
+					return err
+				}
+			}
+			report()
+		}
+	}
+	return nil
+}
+
+// writeTranscriptLine writes seg in TranscriptText format.
+func writeTranscriptLine(w io.Writer, seg TranscriptSegment) error {
+	if strings.TrimSpace(seg.Text) == "" {
+		return nil
+	}
+	var err error
+	if seg.Speaker != "" {
+		_, err = fmt.Fprintf(w, "[%s] %s: %s\n", formatOffset(seg.Start), seg.Speaker, seg.Text)
+	} else {
+		_, err = fmt.Fprintf(w, "[%s] %s\n", formatOffset(seg.Start), seg.Text)
+	}
+	return err
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
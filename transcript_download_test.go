@@ -0,0 +1,199 @@
+package corestream
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const downloadTranscriptBody = `{"language":"en","segments":[
+	{"start":0,"end":2,"text":"welcome back","speaker":"SPEAKER_1"},
+	{"start":2,"end":3,"text":""},
+	{"start":65,"end":67,"text":"thanks for having me"}
+]}`
+
+func TestDownloadTranscript_Formats(t *testing.T) {
+	tests := []struct {
+		format TranscriptFormat
+		want   string
+	}{
+		{"", `{"start":0,"end":2,"text":"welcome back","speaker":"SPEAKER_1"}` + "\n" +
+			`{"start":2,"end":3,"text":""}` + "\n" +
+			`{"start":65,"end":67,"text":"thanks for having me"}` + "\n"},
+		{TranscriptSRT, "1\n00:00:00,000 --> 00:00:02,000\nwelcome back\n\n" +
+			"3\n00:01:05,000 --> 00:01:07,000\nthanks for having me\n\n"},
+		{TranscriptText, "[0:00:00] SPEAKER_1: welcome back\n[0:01:05] thanks for having me\n"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(downloadTranscriptBody))
+			})
+			defer server.Close()
+
+			var b strings.Builder
+			if err := client.DownloadTranscript(context.Background(), "stream_1", &b, &DownloadTranscriptOptions{Format: tt.format}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if b.String() != tt.want {
+				t.Errorf("unexpected output:\n%s\nwant:\n%s", b.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDownloadTranscript_GzipResume(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("diarize"); got != "true" {
+			t.Errorf("expected diarize=true, got %q", got)
+		}
+		w.Write([]byte(downloadTranscriptBody))
+	})
+	defer server.Close()
+
+	var progress []DownloadProgress
+	var buf bytes.Buffer
+	err := client.DownloadTranscript(context.Background(), "stream_1", &buf, &DownloadTranscriptOptions{
+		TranscriptOptions: TranscriptOptions{Diarize: true},
+		Format:            TranscriptText,
+		Gzip:              true,
+		Resume:            2,
+		Progress:          func(p DownloadProgress) { progress = append(progress, p) },
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	zr, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatalf("invalid gzip output: %v", err)
+	}
+	got, _ := io.ReadAll(zr)
+	if want := "[0:01:05] thanks for having me\n"; string(got) != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+	if len(progress) != 1 || progress[0].Segments != 3 {
+		t.Errorf("expected one progress report at segment 3, got %+v", progress)
+	}
+}
+
+func TestDownloadTranscript_PartialFailure(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"segments":[{"start":0,"end":1,"text":"a"},{"start":1,"end":2,"text":"b"},{"start":`))
+	})
+	defer server.Close()
+
+	var last DownloadProgress
+	var b strings.Builder
+	err := client.DownloadTranscript(context.Background(), "stream_1", &b, &DownloadTranscriptOptions{
+		Format:   TranscriptText,
+		Progress: func(p DownloadProgress) { last = p },
+	})
+	if err == nil {
+		t.Fatal("expected an error for a truncated transcript")
+	}
+	if want := "[0:00:00] a\n[0:00:01] b\n"; b.String() != want {
+		t.Errorf("expected written segments to be flushed, got %q", b.String())
+	}
+	if last.Segments != 2 {
+		t.Errorf("expected progress at segment 2, got %d", last.Segments)
+	}
+}
+
+func TestDownloadTranscript_InvalidOptions(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request should be made for invalid options")
+	})
+	defer server.Close()
+
+	for _, opts := range []*DownloadTranscriptOptions{{Format: "pdf"}, {Resume: -1}} {
+		if err := client.DownloadTranscript(context.Background(), "stream_1", io.Discard, opts); err == nil {
+			t.Errorf("expected an error for %+v", opts)
+		}
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestDownloadTranscript_WriteError(t *testing.T) {
+	for _, format := range []TranscriptFormat{TranscriptJSONL, TranscriptSRT, TranscriptText} {
+		t.Run(string(format), func(t *testing.T) {
+			client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(downloadTranscriptBody))
+			})
+			defer server.Close()
+
+			err := client.DownloadTranscript(context.Background(), "stream_1", failingWriter{}, &DownloadTranscriptOptions{Format: format})
+			if err == nil || !strings.Contains(err.Error(), "disk full") {
+				t.Errorf("expected write error, got %v", err)
+			}
+		})
+	}
+}
+
+// limitWriter accepts up to limit bytes and then fails.
+type limitWriter struct {
+	buf   bytes.Buffer
+	limit int
+}
+
+func (w *limitWriter) Write(p []byte) (int, error) {
+	if room := w.limit - w.buf.Len(); len(p) > room {
+		w.buf.Write(p[:max(room, 0)])
+		return max(room, 0), errors.New("disk full")
+	}
+	return w.buf.Write(p)
+}
+
+func TestDownloadTranscript_ProgressIsResumable(t *testing.T) {
+	var body strings.Builder
+	body.WriteString(`{"segments":[`)
+	for i := range 200 {
+		if i > 0 {
+			body.WriteString(",")
+		}
+		fmt.Fprintf(&body, `{"start":%d,"end":%d,"text":"segment text number %03d"}`, i, i+1, i)
+	}
+	body.WriteString(`]}`)
+
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body.String()))
+	})
+	defer server.Close()
+
+	var last DownloadProgress
+	w := &limitWriter{limit: 5000}
+	err := client.DownloadTranscript(context.Background(), "stream_1", w, &DownloadTranscriptOptions{
+		Format:   TranscriptText,
+		Progress: func(p DownloadProgress) { last = p },
+	})
+	if err == nil {
+		t.Fatal("expected a write error")
+	}
+	if last.Segments == 0 {
+		t.Fatal("expected progress before the failure")
+	}
+	if persisted := strings.Count(w.buf.String(), "\n"); persisted < last.Segments {
+		t.Errorf("progress reported %d segments but only %d reached w", last.Segments, persisted)
+	}
+
+	var rest strings.Builder
+	if err := client.DownloadTranscript(context.Background(), "stream_1", &rest, &DownloadTranscriptOptions{
+		Format: TranscriptText,
+		Resume: last.Segments,
+	}); err != nil {
+		t.Fatalf("unexpected error resuming: %v", err)
+	}
+	lines := strings.SplitAfter(w.buf.String(), "\n")
+	if got := strings.Join(lines[:last.Segments], "") + rest.String(); strings.Count(got, "\n") != 200 {
+		t.Errorf("expected 200 segments after resuming, got %d", strings.Count(got, "\n"))
+	}
+}
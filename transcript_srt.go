@@ -0,0 +1,59 @@
+package corestream
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// GetStreamTranscriptSRT retrieves the transcript for a stream as SubRip
+// (SRT) subtitles, e.g. for overlaying on a downloaded VOD.
+func (c *Client) GetStreamTranscriptSRT(ctx context.Context, streamID string) (io.Reader, error) {
+	transcript, err := c.GetStreamTranscript(ctx, streamID)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := transcript.WriteSRT(&buf); err != nil {
+		return nil, err
+	}
+	return &buf, nil
+}
+
+// WriteSRT writes the transcript to w as SubRip (SRT) subtitles, one cue per
+// segment. Segments without text are skipped, and line breaks within a
+// segment are folded so they can't end a cue early.
+func (t *TranscriptResponse) WriteSRT(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	cue := 0
+	for _, seg := range t.Segments {
+		written, err := writeSRTCue(bw, cue+1, seg)
+		if err != nil {
+			return err
+		}
+		if written {
+			cue++
+		}
+	}
+	return bw.Flush()
+}
+
+// writeSRTCue writes seg as cue number n and reports whether it was written.
+func writeSRTCue(w io.Writer, n int, seg TranscriptSegment) (bool, error) {
+	text := strings.Join(strings.Fields(seg.Text), " ")
+	if text == "" {
+		return false, nil
+	}
+	_, err := fmt.Fprintf(w, "%d\n%s --> %s\n%s\n\n", n, srtTimestamp(seg.Start), srtTimestamp(max(seg.End, seg.Start)), text)
+	return err == nil, err
+}
+
+// srtTimestamp formats an offset in seconds as HH:MM:SS,mmm.
+func srtTimestamp(seconds float64) string {
+	ms := int64(math.Round(max(seconds, 0) * 1000))
+	return fmt.Sprintf("%02d:%02d:%02d,%03d", ms/3600000, ms/60000%60, ms/1000%60, ms%1000)
+}
@@ -0,0 +1,64 @@
+package corestream
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestTranscriptResponse_WriteSRT(t *testing.T) {
+	transcript := &TranscriptResponse{Segments: []TranscriptSegment{
+		{Start: 0, End: 2.5, Text: "Hello everyone"},
+		{Start: 3, End: 3, Text: "   "},
+		{Start: 3661.0004, End: 3663.9999, Text: "first line\n\nsecond line"},
+	}}
+
+	var b strings.Builder
+	if err := transcript.WriteSRT(&b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "1\n00:00:00,000 --> 00:00:02,500\nHello everyone\n\n" +
+		"2\n01:01:01,000 --> 01:01:04,000\nfirst line second line\n\n"
+	if b.String() != want {
+		t.Errorf("unexpected SRT output:\n%s\nwant:\n%s", b.String(), want)
+	}
+}
+
+func TestSRTTimestamp(t *testing.T) {
+	tests := []struct {
+		seconds float64
+		want    string
+	}{
+		{0, "00:00:00,000"},
+		{-1, "00:00:00,000"},
+		{59.9996, "00:01:00,000"},
+		{36000.123, "10:00:00,123"},
+	}
+	for _, tt := range tests {
+		if got := srtTimestamp(tt.seconds); got != tt.want {
+			t.Errorf("srtTimestamp(%v) = %s, want %s", tt.seconds, got, tt.want)
+		}
+	}
+}
+
+func TestGetStreamTranscriptSRT(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/streams/stream_1/transcript" {
+			t.Errorf("expected path /v2/streams/stream_1/transcript, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"segments":[{"start":1.2,"end":4,"text":"hi chat"}]}`))
+	})
+	defer server.Close()
+
+	r, err := client.GetStreamTranscriptSRT(context.Background(), "stream_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, _ := io.ReadAll(r)
+	if want := "1\n00:00:01,200 --> 00:00:04,000\nhi chat\n\n"; string(got) != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,131 @@
+package corestream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// StreamTranscriptSegments retrieves the transcript for a stream and decodes
+// its segments one at a time as the response arrives, instead of holding the
+// whole transcript in memory as GetStreamTranscript does.
+//
+// The request is sent when a loop over the iterator starts, and the response
+// is closed when the loop ends, including on break. Errors, whether from the
+// request itself, such as a missing stream, or from decoding, are yielded by
+// the iterator, which then stops.
+func (c *Client) StreamTranscriptSegments(ctx context.Context, streamID string) iter.Seq2[TranscriptSegment, error] {
+	return func(yield func(TranscriptSegment, error) bool) {
+		segments, err := c.streamTranscriptSegments(ctx, streamID, nil)
+		if err != nil {
+			yield(TranscriptSegment{}, err)
+			return
+		}
+		segments(yield)
+	}
+}
+
+// streamTranscriptSegments sends the transcript request and returns an
+// iterator over the response. The response is only closed by ranging over
+// the iterator, so callers must do so.
+func (c *Client) streamTranscriptSegments(ctx context.Context, streamID string, query url.Values) (iter.Seq2[TranscriptSegment, error], error) {
+	path := fmt.Sprintf("/v2/streams/%s/transcript", streamID)
+	body, done, err := c.openStream(ctx, http.MethodGet, path, query)
+	if err != nil {
+		return nil, err
+	}
+	return func(yield func(TranscriptSegment, error) bool) {
+		var err error
+		defer func() { done(err) }()
+		err = decodeSegments(json.NewDecoder(body), func(seg TranscriptSegment) bool {
+			return yield(seg, nil)
+		})
+		if err != nil {
+			err = fmt.Errorf("corestream: failed to decode transcript: %w", err)
+			yield(TranscriptSegment{}, err)
+		}
+	}, nil
+}
+
+// decodeSegments walks a transcript response object, passing each element
+// of its "segments" array to fn until fn returns false.
+func decodeSegments(dec *json.Decoder, fn func(TranscriptSegment) bool) error {
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if key, _ := tok.(string); key != "segments" {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return err
+			}
+			continue
+		}
+		tok, err = dec.Token()
+		if err != nil {
+			return err
+		}
+		if tok == nil {
+			continue // "segments": null
+		}
+		if d, ok := tok.(json.Delim); !ok || d != '[' {
+			return fmt.Errorf("segments: expected array, got %v", tok)
+		}
+		for dec.More() {
+			var seg TranscriptSegment
+			if err := dec.Decode(&seg); err != nil {
+				return err
+			}
+			if !fn(seg) {
+				return nil
+			}
+		}
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != want {
+		return fmt.Errorf("expected %q, got %v", want, tok)
+	}
+	return nil
+}
+
+// limitedReader fails with ErrResponseTooLarge once more than limit bytes
+// have been read.
+type limitedReader struct {
+	r     io.Reader
+	limit int64
+	read  int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.read > l.limit {
+		return 0, fmt.Errorf("%w: limit is %d bytes", ErrResponseTooLarge, l.limit)
+	}
+	if rem := l.limit + 1 - l.read; int64(len(p)) > rem {
+		p = p[:rem]
+	}
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	if l.read > l.limit {
+		// Withhold the byte past the limit so it is never decoded.
+		return n - 1, fmt.Errorf("%w: limit is %d bytes", ErrResponseTooLarge, l.limit)
+	}
+	return n, err
+}
@@ -0,0 +1,177 @@
+package corestream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStreamTranscriptSegments(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/streams/stream_1/transcript" {
+			t.Errorf("expected path /v2/streams/stream_1/transcript, got %s", r.URL.Path)
+		}
+		var b strings.Builder
+		b.WriteString(`{"stream_id":"stream_1","meta":{"x":[1,2]},"segments":[`)
+		for i := range 1000 {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			fmt.Fprintf(&b, `{"start":%d,"end":%d,"text":"segment %d"}`, i, i+1, i)
+		}
+		b.WriteString(`]}`)
+		w.Write([]byte(b.String()))
+	})
+	defer server.Close()
+
+	segments := client.StreamTranscriptSegments(context.Background(), "stream_1")
+	count := 0
+	for seg, err := range segments {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := fmt.Sprintf("segment %d", count); seg.Text != want || seg.Start != float64(count) {
+			t.Fatalf("segment %d: unexpected %+v", count, seg)
+		}
+		count++
+	}
+	if count != 1000 {
+		t.Errorf("expected 1000 segments, got %d", count)
+	}
+}
+
+func TestStreamTranscriptSegments_Break(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"segments":[{"start":0,"end":1,"text":"a"},{"start":1,"end":2,"text":"b"}]}`))
+	})
+	defer server.Close()
+
+	segments := client.StreamTranscriptSegments(context.Background(), "stream_1")
+	var got []string
+	for seg, err := range segments {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, seg.Text)
+		break
+	}
+	if len(got) != 1 || got[0] != "a" {
+		t.Errorf("expected only the first segment, got %v", got)
+	}
+}
+
+func TestStreamTranscriptSegments_NotFound(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"code":"not_found","message":"stream not found"}}`))
+	})
+	defer server.Close()
+
+	var errs []error
+	for _, err := range client.StreamTranscriptSegments(context.Background(), "missing") {
+		errs = append(errs, err)
+	}
+	if len(errs) != 1 || !IsNotFound(errs[0]) {
+		t.Errorf("expected a single not found error, got %v", errs)
+	}
+}
+
+func TestStreamTranscriptSegments_Lazy(t *testing.T) {
+	requests := 0
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"segments":[{"start":0,"end":1,"text":"a"}]}`))
+	})
+	defer server.Close()
+
+	segments := client.StreamTranscriptSegments(context.Background(), "stream_1")
+	if requests != 0 {
+		t.Fatalf("expected no request before ranging, got %d", requests)
+	}
+	for range 2 {
+		for _, err := range segments {
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+	}
+	if requests != 2 {
+		t.Errorf("expected a request per loop, got %d", requests)
+	}
+}
+
+func TestStreamTranscriptSegments_SlowCall(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"segments":[{"start":0,"end":1,"text":"a"}]}`))
+	}))
+	defer server.Close()
+
+	var calls []SlowCall
+	client, err := NewClient("test-token", WithBaseURL(server.URL),
+		WithSlowCallThreshold(time.Nanosecond, func(call SlowCall) { calls = append(calls, call) }))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, err := range client.StreamTranscriptSegments(context.Background(), "stream_1") {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if len(calls) != 1 || calls[0].Endpoint != "/v2/streams/stream_1/transcript" || calls[0].StatusCode != http.StatusOK {
+		t.Errorf("expected one traced call, got %+v", calls)
+	}
+}
+
+func TestStreamTranscriptSegments_DecodeErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		opts []Option
+		is   error
+	}{
+		{"truncated", `{"segments":[{"start":0,"end":1,"text":"a"},{"start":`, nil, nil},
+		{"not an array", `{"segments":{"start":0}}`, nil, nil},
+		{"too large", `{"segments":[{"start":0,"end":1,"text":"` + strings.Repeat("a", 200) + `"}]}`, []Option{WithMaxResponseSize(100)}, ErrResponseTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+			client, err := NewClient("test-token", append([]Option{WithBaseURL(server.URL)}, tt.opts...)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			segments := client.StreamTranscriptSegments(context.Background(), "stream_1")
+			var last error
+			for _, err := range segments {
+				last = err
+			}
+			if last == nil {
+				t.Fatal("expected a decode error")
+			}
+			if tt.is != nil && !errors.Is(last, tt.is) {
+				t.Errorf("expected %v, got %v", tt.is, last)
+			}
+		})
+	}
+}
+
+func TestStreamTranscriptSegments_NullSegments(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"segments":null}`))
+	})
+	defer server.Close()
+
+	segments := client.StreamTranscriptSegments(context.Background(), "stream_1")
+	for _, err := range segments {
+		t.Errorf("expected no segments, got error %v", err)
+	}
+}
@@ -1,6 +1,8 @@
 package corestream
 
 import (
+	"bytes"
+	"encoding/json"
 	"net/http"
 	"time"
 )
@@ -18,28 +20,183 @@ type Pagination struct {
 	TotalPages int `json:"total_pages"`
 }
 
+// SortOrder is the direction in which list results are sorted.
+type SortOrder string
+
+// Sort orders.
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+// AlertSortKey is a field alerts can be sorted by.
+type AlertSortKey string
+
+// Alert sort keys.
+const (
+	AlertSortByName      AlertSortKey = "name"
+	AlertSortByCreatedAt AlertSortKey = "created_at"
+	AlertSortByUpdatedAt AlertSortKey = "updated_at"
+)
+
+// StreamSortKey is a field streams can be sorted by.
+type StreamSortKey string
+
+// Stream sort keys.
+const (
+	StreamSortByStartedAt StreamSortKey = "started_at"
+	StreamSortByDuration  StreamSortKey = "duration_seconds"
+	StreamSortByCreatedAt StreamSortKey = "created_at"
+)
+
+// SearchSortKey is a field search results can be sorted by.
+type SearchSortKey string
+
+// Search sort keys. The API sorts by relevance by default.
+const (
+	SearchSortByRelevance   SearchSortKey = "relevance"
+	SearchSortByDate        SearchSortKey = "date"
+	SearchSortByViewerCount SearchSortKey = "viewer_count"
+)
+
+// NotificationSortKey is a field notifications can be sorted by.
+type NotificationSortKey string
+
+// Notification sort keys.
+const (
+	NotificationSortByTimestamp     NotificationSortKey = "timestamp"
+	NotificationSortByMatchedPhrase NotificationSortKey = "matched_phrase"
+)
+
+// MatchOptions controls how an alert's phrases are matched against transcripts.
+// The zero value matches case-insensitively, anywhere in a word, without
+// fuzzy matching.
+type MatchOptions struct {
+	CaseSensitive bool `json:"case_sensitive"`
+	WholeWord     bool `json:"whole_word"`
+	// FuzzyDistance is the maximum edit distance for a fuzzy match, from 0
+	// (exact) to MaxFuzzyDistance.
+	FuzzyDistance int `json:"fuzzy_distance,omitempty"`
+}
+
+// Priority is an alert's severity, echoed in its notifications so that
+// downstream systems can route them.
+type Priority string
+
+// Alert priorities.
+const (
+	PriorityLow    Priority = "low"
+	PriorityMedium Priority = "medium"
+	PriorityHigh   Priority = "high"
+)
+
+// Valid reports whether p is one of the known priorities.
+func (p Priority) Valid() bool {
+	switch p {
+	case PriorityLow, PriorityMedium, PriorityHigh:
+		return true
+	}
+	return false
+}
+
 // Alert represents an alert configuration.
+// PhrasePatterns are regular expressions (RE2 syntax) matched in addition to Phrases.
+// Matches whose context contains any of ExcludePhrases are suppressed.
+// If StreamerIDs is set, only those streamers are watched; streamers in
+// ExcludeStreamerIDs are never watched. If Categories is set, phrases are only
+// matched in streams whose category (e.g. "Just Chatting") is in the list.
+// If Schedule is set, the alert only fires webhooks while the schedule is active.
+// ArchivedAt is set once the alert has been archived with ArchiveAlert.
 type Alert struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name"`
-	Phrases   []string  `json:"phrases"`
-	IsActive  bool      `json:"is_active"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID                 string        `json:"id"`
+	Name               string        `json:"name"`
+	Phrases            []string      `json:"phrases"`
+	PhrasePatterns     []string      `json:"phrase_patterns,omitempty"`
+	ExcludePhrases     []string      `json:"exclude_phrases,omitempty"`
+	MatchOptions       MatchOptions  `json:"match_options"`
+	StreamerIDs        []string      `json:"streamer_ids,omitempty"`
+	ExcludeStreamerIDs []string      `json:"exclude_streamer_ids,omitempty"`
+	Categories         []string      `json:"categories,omitempty"`
+	Schedule           *Schedule     `json:"schedule,omitempty"`
+	Digest             *DigestConfig `json:"digest,omitempty"`
+	Priority           Priority      `json:"priority,omitempty"`
+	Tags               []string      `json:"tags,omitempty"`
+	GroupID            string        `json:"group_id,omitempty"`
+	IsActive           bool          `json:"is_active"`
+	CreatedAt          time.Time     `json:"created_at"`
+	UpdatedAt          time.Time     `json:"updated_at"`
+	ArchivedAt         *time.Time    `json:"archived_at,omitempty"`
+
+	// Webhook is only populated when listing with IncludeWebhook.
+	Webhook *AlertWebhookSummary `json:"webhook,omitempty"`
+}
+
+// AlertWebhookSummary summarizes the webhook configured for an alert.
+type AlertWebhookSummary struct {
+	Configured         bool      `json:"configured"`
+	IsActive           bool      `json:"is_active"`
+	LastDeliveryStatus string    `json:"last_delivery_status,omitempty"`
+	LastDeliveryAt     time.Time `json:"last_delivery_at,omitzero"`
 }
 
 // CreateAlertRequest is the request body for creating an alert.
 type CreateAlertRequest struct {
-	Name     string   `json:"name"`
-	Phrases  []string `json:"phrases"`
-	IsActive *bool    `json:"is_active,omitempty"`
+	Name               string        `json:"name"`
+	Phrases            []string      `json:"phrases"`
+	PhrasePatterns     []string      `json:"phrase_patterns,omitempty"`
+	ExcludePhrases     []string      `json:"exclude_phrases,omitempty"`
+	MatchOptions       *MatchOptions `json:"match_options,omitempty"`
+	StreamerIDs        []string      `json:"streamer_ids,omitempty"`
+	ExcludeStreamerIDs []string      `json:"exclude_streamer_ids,omitempty"`
+	Categories         []string      `json:"categories,omitempty"`
+	Schedule           *Schedule     `json:"schedule,omitempty"`
+	Digest             *DigestConfig `json:"digest,omitempty"`
+	Priority           Priority      `json:"priority,omitempty"`
+	Tags               []string      `json:"tags,omitempty"`
+	IsActive           *bool         `json:"is_active,omitempty"`
 }
 
 // UpdateAlertRequest is the request body for updating an alert.
 type UpdateAlertRequest struct {
-	Name     *string  `json:"name,omitempty"`
-	Phrases  []string `json:"phrases,omitempty"`
-	IsActive *bool    `json:"is_active,omitempty"`
+	Name               *string       `json:"name,omitempty"`
+	Phrases            []string      `json:"phrases,omitempty"`
+	PhrasePatterns     []string      `json:"phrase_patterns,omitempty"`
+	ExcludePhrases     []string      `json:"exclude_phrases,omitempty"`
+	MatchOptions       *MatchOptions `json:"match_options,omitempty"`
+	StreamerIDs        []string      `json:"streamer_ids,omitempty"`
+	ExcludeStreamerIDs []string      `json:"exclude_streamer_ids,omitempty"`
+	Categories         []string      `json:"categories,omitempty"`
+	Schedule           *Schedule     `json:"schedule,omitempty"`
+	Digest             *DigestConfig `json:"digest,omitempty"`
+	Priority           Priority      `json:"priority,omitempty"`
+	Tags               []string      `json:"tags,omitempty"`
+	IsActive           *bool         `json:"is_active,omitempty"`
+}
+
+// ListAlertsOptions contains options for listing alerts.
+type ListAlertsOptions struct {
+	Page     int
+	PageSize int
+
+	SortBy    AlertSortKey
+	SortOrder SortOrder
+
+	// Filters. Zero values are not sent.
+	IsActive      *bool
+	NameContains  string
+	Phrase        string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+
+	// Tags restricts results to alerts that have all of the given tags.
+	Tags []string
+
+	// Archived selects archived (true) or unarchived (false) alerts.
+	// When nil, archived alerts are excluded.
+	Archived *bool
+
+	// IncludeWebhook embeds a webhook summary in each returned alert.
+	IncludeWebhook bool
 }
 
 // ListAlertsResponse is the response for listing alerts.
@@ -48,17 +205,151 @@ type ListAlertsResponse struct {
 	Pagination Pagination `json:"pagination"`
 }
 
+// AlertGroup is a named collection of alerts that can be managed together.
+type AlertGroup struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	AlertIDs    []string  `json:"alert_ids"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CreateAlertGroupRequest is the request body for creating an alert group.
+type CreateAlertGroupRequest struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	AlertIDs    []string `json:"alert_ids,omitempty"`
+}
+
+// UpdateAlertGroupRequest is the request body for updating an alert group.
+type UpdateAlertGroupRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ListAlertGroupsOptions contains options for listing alert groups.
+type ListAlertGroupsOptions struct {
+	Page     int
+	PageSize int
+}
+
+// ListAlertGroupsResponse is the response for listing alert groups.
+type ListAlertGroupsResponse struct {
+	AlertGroups []AlertGroup `json:"alert_groups"`
+	Pagination  Pagination   `json:"pagination"`
+}
+
+// AlertGroupMembersRequest is the request body for adding or removing alerts
+// from a group.
+type AlertGroupMembersRequest struct {
+	AlertIDs []string `json:"alert_ids"`
+}
+
+// SetAlertGroupActiveRequest is the request body for enabling or disabling
+// every alert in a group.
+type SetAlertGroupActiveRequest struct {
+	IsActive bool `json:"is_active"`
+}
+
+// BulkUpdateResponse reports how many resources a bulk operation changed.
+type BulkUpdateResponse struct {
+	Updated int `json:"updated"`
+}
+
+// AlertChange is a single entry in an alert's change history.
+type AlertChange struct {
+	ID      string `json:"id"`
+	AlertID string `json:"alert_id"`
+	// Action is what happened, e.g. "created", "updated", "activated", "deactivated".
+	Action string `json:"action"`
+	// Actor identifies who made the change: a user email, or the name of the
+	// API key used.
+	Actor     string        `json:"actor"`
+	ChangedAt time.Time     `json:"changed_at"`
+	Fields    []FieldChange `json:"fields,omitempty"`
+}
+
+// FieldChange records the old and new value of one alert field.
+// Values are raw JSON so that any field type can be represented.
+type FieldChange struct {
+	Field    string          `json:"field"`
+	OldValue json.RawMessage `json:"old_value,omitempty"`
+	NewValue json.RawMessage `json:"new_value,omitempty"`
+}
+
+// AlertHistoryResponse is the response for getting an alert's change history.
+type AlertHistoryResponse struct {
+	Changes []AlertChange `json:"changes"`
+}
+
+// TestAlertRequest is the request body for dry-run matching an alert.
+// Exactly one of Text or StreamID should be set.
+type TestAlertRequest struct {
+	Text     string `json:"text,omitempty"`
+	StreamID string `json:"stream_id,omitempty"`
+}
+
+// AlertMatch is a single place where an alert's phrases matched during a test.
+type AlertMatch struct {
+	// Phrase is the alert phrase or pattern that matched.
+	Phrase string `json:"phrase"`
+	// MatchedText is the text that matched, which may differ from Phrase
+	// for fuzzy or case-insensitive matches.
+	MatchedText string `json:"matched_text"`
+	// Offset is the byte offset of the match within sample text.
+	Offset int `json:"offset"`
+	// Start and End are the transcript position of the match in seconds
+	// when testing against a stream.
+	Start   float64 `json:"start,omitempty"`
+	End     float64 `json:"end,omitempty"`
+	Context string  `json:"context"`
+}
+
+// TestAlertResponse is the result of dry-run matching an alert.
+type TestAlertResponse struct {
+	Matches []AlertMatch `json:"matches"`
+	// Excluded lists matches that were suppressed by the alert's exclude phrases.
+	Excluded []AlertMatch `json:"excluded,omitempty"`
+}
+
 // Notification represents an alert notification.
 type Notification struct {
 	ID            string    `json:"id"`
 	AlertID       string    `json:"alert_id"`
 	AlertName     string    `json:"alert_name"`
+	StreamID      string    `json:"stream_id,omitempty"`
+	StreamerID    string    `json:"streamer_id,omitempty"`
 	MatchedPhrase string    `json:"matched_phrase"`
+	Priority      Priority  `json:"priority,omitempty"`
 	Context       string    `json:"context"`
 	StreamSource  string    `json:"stream_source"`
 	StreamTitle   string    `json:"stream_title"`
 	Timestamp     time.Time `json:"timestamp"`
 	TranscriptURL string    `json:"transcript_url,omitempty"`
+
+	// Acknowledged is set once the notification has been marked as handled
+	// with AckNotification. ReadAt is when that happened.
+	Acknowledged bool       `json:"acknowledged"`
+	ReadAt       *time.Time `json:"read_at,omitempty"`
+}
+
+// ListNotificationsOptions contains options for listing alert notifications.
+type ListNotificationsOptions struct {
+	Page      int
+	PageSize  int
+	SortBy    NotificationSortKey
+	SortOrder SortOrder
+
+	// Filters. Zero values are not sent.
+	Since         time.Time
+	Until         time.Time
+	MatchedPhrase string
+	StreamerID    string
+	StreamID      string
+
+	// Unread restricts results to notifications that have not been acknowledged.
+	Unread bool
 }
 
 // ListNotificationsResponse is the response for listing alert notifications.
@@ -67,52 +358,221 @@ type ListNotificationsResponse struct {
 	Pagination    Pagination     `json:"pagination"`
 }
 
-// Webhook represents a webhook configuration.
+// EventType identifies the kind of event a webhook delivery carries.
+type EventType string
+
+// Webhook event types.
+const (
+	EventNotificationCreated EventType = "notification.created"
+	EventAlertUpdated        EventType = "alert.updated"
+	EventStreamProcessed     EventType = "stream.processed"
+	EventNotificationDigest  EventType = "notification.digest"
+	EventWebhookTest         EventType = "webhook.test"
+)
+
+// PayloadFormat selects the overall shape of webhook deliveries.
+type PayloadFormat string
+
+// Webhook payload formats.
+const (
+	// PayloadFormatDefault delivers WebhookNotification payloads.
+	PayloadFormatDefault PayloadFormat = "default"
+	// PayloadFormatSlack delivers Slack incoming-webhook messages, so the
+	// webhook URL can point directly at Slack. These deliveries cannot be
+	// parsed by WebhookReceiver.
+	PayloadFormatSlack PayloadFormat = "slack"
+)
+
+// PayloadOptions customizes webhook delivery payloads. IncludeFields and
+// ExcludeFields use the JSON field names of WebhookNotification, e.g.
+// "full_transcript" or "context_text", and only apply to the default format.
+type PayloadOptions struct {
+	Format        PayloadFormat `json:"format,omitempty"`
+	IncludeFields []string      `json:"include_fields,omitempty"`
+	ExcludeFields []string      `json:"exclude_fields,omitempty"`
+}
+
+// WebhookBatchConfig makes a webhook deliver JSON arrays of notifications
+// instead of one notification per request. A batch is sent when it reaches
+// MaxSize or when its oldest notification has waited MaxWait.
+type WebhookBatchConfig struct {
+	MaxSize int
+	MaxWait time.Duration
+}
+
+type webhookBatchConfigJSON struct {
+	MaxSize       int   `json:"max_size"`
+	MaxWaitMillis int64 `json:"max_wait_ms"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b WebhookBatchConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(webhookBatchConfigJSON{
+		MaxSize:       b.MaxSize,
+		MaxWaitMillis: b.MaxWait.Milliseconds(),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *WebhookBatchConfig) UnmarshalJSON(data []byte) error {
+	var v webhookBatchConfigJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	b.MaxSize = v.MaxSize
+	b.MaxWait = time.Duration(v.MaxWaitMillis) * time.Millisecond
+	return nil
+}
+
+// Webhook represents a webhook configuration. AlertID is empty for
+// account-level (global) webhooks.
 type Webhook struct {
-	ID                    string    `json:"id"`
-	AlertID               string    `json:"alert_id"`
-	URL                   string    `json:"url"`
-	Secret                string    `json:"secret,omitempty"`
-	IsActive              bool      `json:"is_active"`
-	IncludeFullTranscript bool      `json:"include_full_transcript"`
-	CreatedAt             time.Time `json:"created_at"`
-	UpdatedAt             time.Time `json:"updated_at"`
+	ID                    string              `json:"id"`
+	AlertID               string              `json:"alert_id"`
+	URL                   string              `json:"url"`
+	Secret                string              `json:"secret,omitempty"`
+	SecretKeyID           string              `json:"secret_key_id,omitempty"`
+	IsActive              bool                `json:"is_active"`
+	IncludeFullTranscript bool                `json:"include_full_transcript"`
+	Events                []EventType         `json:"events,omitempty"`
+	Payload               *PayloadOptions     `json:"payload,omitempty"`
+	Batch                 *WebhookBatchConfig `json:"batch,omitempty"`
+	CreatedAt             time.Time           `json:"created_at"`
+	UpdatedAt             time.Time           `json:"updated_at"`
 }
 
 // CreateWebhookRequest is the request body for creating a webhook.
+// Events selects which events are delivered; empty subscribes to
+// EventNotificationCreated only.
 type CreateWebhookRequest struct {
-	URL                   string `json:"url"`
-	Secret                string `json:"secret,omitempty"`
-	IsActive              *bool  `json:"is_active,omitempty"`
-	IncludeFullTranscript *bool  `json:"include_full_transcript,omitempty"`
+	URL                   string              `json:"url"`
+	Secret                string              `json:"secret,omitempty"`
+	IsActive              *bool               `json:"is_active,omitempty"`
+	IncludeFullTranscript *bool               `json:"include_full_transcript,omitempty"`
+	Events                []EventType         `json:"events,omitempty"`
+	Payload               *PayloadOptions     `json:"payload,omitempty"`
+	Batch                 *WebhookBatchConfig `json:"batch,omitempty"`
 }
 
 // UpdateWebhookRequest is the request body for updating a webhook.
 type UpdateWebhookRequest struct {
-	URL                   string `json:"url"`
-	Secret                string `json:"secret,omitempty"`
-	IsActive              bool   `json:"is_active"`
-	IncludeFullTranscript bool   `json:"include_full_transcript"`
+	URL                   string              `json:"url"`
+	Secret                string              `json:"secret,omitempty"`
+	IsActive              bool                `json:"is_active"`
+	IncludeFullTranscript bool                `json:"include_full_transcript"`
+	Events                []EventType         `json:"events,omitempty"`
+	Payload               *PayloadOptions     `json:"payload,omitempty"`
+	Batch                 *WebhookBatchConfig `json:"batch,omitempty"`
+}
+
+// SetWebhookActiveRequest is the request body for pausing or resuming
+// webhook delivery.
+type SetWebhookActiveRequest struct {
+	IsActive bool `json:"is_active"`
+}
+
+// StatsPeriod is the window that webhook delivery statistics cover.
+type StatsPeriod string
+
+// Webhook statistics periods.
+const (
+	StatsPeriodDay   StatsPeriod = "24h"
+	StatsPeriodWeek  StatsPeriod = "7d"
+	StatsPeriodMonth StatsPeriod = "30d"
+)
+
+// WebhookStats summarizes webhook delivery health over a period.
+type WebhookStats struct {
+	Period      StatsPeriod `json:"period"`
+	Deliveries  int         `json:"deliveries"`
+	Successes   int         `json:"successes"`
+	Failures    int         `json:"failures"`
+	SuccessRate float64     `json:"success_rate"`
+
+	P50LatencyMs float64 `json:"p50_latency_ms"`
+	P95LatencyMs float64 `json:"p95_latency_ms"`
+
+	// FailuresByStatus counts failed deliveries by the HTTP status code the
+	// endpoint returned. Deliveries that got no response are counted in
+	// Timeouts and ConnectionErrors instead.
+	FailuresByStatus map[int]int `json:"failures_by_status"`
+	Timeouts         int         `json:"timeouts"`
+	ConnectionErrors int         `json:"connection_errors"`
+}
+
+// P95Latency returns the 95th percentile delivery latency.
+func (s *WebhookStats) P95Latency() time.Duration {
+	return time.Duration(s.P95LatencyMs * float64(time.Millisecond))
+}
+
+// ListGlobalWebhooksResponse is the response for listing account-level webhooks.
+type ListGlobalWebhooksResponse struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// RotateWebhookSecretResponse is the response for rotating a webhook secret.
+// The new secret is only returned once.
+type RotateWebhookSecretResponse struct {
+	Secret    string    `json:"secret"`
+	KeyID     string    `json:"key_id"`
+	RotatedAt time.Time `json:"rotated_at"`
+}
+
+// WebhookSecretResponse is the response for revealing a webhook secret.
+type WebhookSecretResponse struct {
+	Secret    string    `json:"secret"`
+	KeyID     string    `json:"key_id"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 // TestWebhookRequest is the request body for testing a webhook.
 type TestWebhookRequest struct {
-	URL                   string `json:"url,omitempty"`
-	Secret                string `json:"secret,omitempty"`
-	IncludeFullTranscript *bool  `json:"include_full_transcript,omitempty"`
+	URL                   string          `json:"url,omitempty"`
+	Secret                string          `json:"secret,omitempty"`
+	IncludeFullTranscript *bool           `json:"include_full_transcript,omitempty"`
+	Payload               *PayloadOptions `json:"payload,omitempty"`
 }
 
-// Stream represents a stream.
+// Stream represents a stream. Category is the name of the stream's category,
+// as used in Alert.Categories, and GameName is set when that category is a
+// game. Language is the ISO 639-1 code of the language detected in the
+// stream's audio. EndedAt is nil while the stream is live.
 type Stream struct {
-	ID              string    `json:"id"`
-	StreamerID      string    `json:"streamer_id"`
-	TwitchID        string    `json:"twitch_id,omitempty"`
-	Title           string    `json:"title,omitempty"`
-	VodID           string    `json:"vod_id,omitempty"`
-	VodURL          string    `json:"vod_url,omitempty"`
-	StartedAt       time.Time `json:"started_at"`
-	DurationSeconds int       `json:"duration_seconds,omitempty"`
-	CreatedAt       time.Time `json:"created_at"`
+	ID              string     `json:"id"`
+	StreamerID      string     `json:"streamer_id"`
+	TwitchID        string     `json:"twitch_id,omitempty"`
+	Title           string     `json:"title,omitempty"`
+	VodID           string     `json:"vod_id,omitempty"`
+	VodURL          string     `json:"vod_url,omitempty"`
+	StartedAt       time.Time  `json:"started_at"`
+	DurationSeconds int        `json:"duration_seconds,omitempty"`
+	Category        string     `json:"category,omitempty"`
+	CategoryID      string     `json:"category_id,omitempty"`
+	GameName        string     `json:"game_name,omitempty"`
+	Language        string     `json:"language,omitempty"`
+	IsLive          bool       `json:"is_live"`
+	EndedAt         *time.Time `json:"ended_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+// ListStreamsOptions contains options for listing streams.
+type ListStreamsOptions struct {
+	Page       int
+	PageSize   int
+	StreamerID string
+	SortBy     StreamSortKey
+	SortOrder  SortOrder
+
+	// StartedAfter and StartedBefore restrict results to streams started in
+	// [StartedAfter, StartedBefore). Zero values are not sent.
+	StartedAfter  time.Time
+	StartedBefore time.Time
+
+	// Filters. Zero values are not sent.
+	MinDurationSeconds int
+	HasVOD             *bool
+	Category           string
+	Language           string
 }
 
 // ListStreamsResponse is the response for listing streams.
@@ -134,26 +594,286 @@ type SearchResult struct {
 	UserDisplayName string    `json:"user_display_name"`
 	Highlights      []string  `json:"highlights"`
 	CreatedAt       time.Time `json:"created_at"`
+
+	// Matches holds each highlight with the offset of its match in the
+	// stream, in the same order as Highlights.
+	Matches []SearchMatch `json:"matches,omitempty"`
+}
+
+// SearchMatch is a search highlight located in its stream. MatchStart is the
+// offset of the match in seconds from the start of the stream, like
+// TranscriptSegment.Start.
+type SearchMatch struct {
+	Highlight  string  `json:"highlight"`
+	MatchStart float64 `json:"match_start"`
+}
+
+// Offset returns MatchStart as a duration, for use with Stream.VODLinkAt.
+func (m SearchMatch) Offset() time.Duration {
+	return time.Duration(m.MatchStart * float64(time.Second))
+}
+
+// SearchMode selects how a search query is matched against transcripts.
+type SearchMode string
+
+// Search modes. SearchKeyword matches words and quoted phrases and is the
+// default. SearchSemantic matches transcripts by meaning, so a query can
+// describe what was said rather than quote it. Accounts without semantic
+// search get an *APIError.
+const (
+	SearchKeyword  SearchMode = "keyword"
+	SearchSemantic SearchMode = "semantic"
+)
+
+// SearchAggregation is a field search results can be counted by.
+type SearchAggregation string
+
+// Search aggregations. Day buckets are keyed by UTC date, e.g. "2024-03-01".
+const (
+	AggregateByStreamer SearchAggregation = "streamer"
+	AggregateByDay      SearchAggregation = "day"
+	AggregateByCategory SearchAggregation = "category"
+)
+
+// AggregationBucket is the number of search results sharing a key, such as
+// a streamer ID, day, or category name.
+type AggregationBucket struct {
+	Key   string `json:"key"`
+	Count int    `json:"count"`
+}
+
+// Search time ranges.
+const (
+	TimeRangeToday = "today"
+	TimeRangeWeek  = "week"
+	TimeRangeMonth = "month"
+)
+
+// SearchStreamsOptions contains options for searching streams.
+type SearchStreamsOptions struct {
+	Page     int
+	PageSize int
+	Mode     SearchMode
+
+	// TimeRange is one of TimeRangeToday, TimeRangeWeek, or TimeRangeMonth.
+	// The API defaults to TimeRangeToday.
+	TimeRange string
+
+	// From and To restrict results to streams in the window [From, To)
+	// instead of TimeRange. Either may be zero to leave that side open. They
+	// cannot be combined with TimeRange.
+	From time.Time
+	To   time.Time
+
+	// StreamerIDs restricts results to streams by any of the given streamers.
+	StreamerIDs []string
+
+	// Categories and Languages restrict results to streams in any of the
+	// given categories, as listed by ListCategories, and any of the given
+	// ISO 639-1 languages.
+	Categories []string
+	Languages  []string
+
+	SortBy    SearchSortKey
+	SortOrder SortOrder
+
+	// HighlightTag is the name of the HTML element wrapping matches in
+	// SearchResult.Highlights, such as "mark". The API defaults to "em".
+	// FragmentSize is the approximate length of each highlight in characters
+	// and MaxFragments caps the number of highlights per result. Zero values
+	// are not sent.
+	HighlightTag string
+	FragmentSize int
+	MaxFragments int
+
+	// Aggregations requests counts of all matching results, not just the
+	// current page, grouped by each of the given fields.
+	Aggregations []SearchAggregation
 }
 
 // SearchStreamsResponse is the response for searching streams.
 type SearchStreamsResponse struct {
 	Results    []SearchResult `json:"results"`
 	Pagination Pagination     `json:"pagination"`
+
+	// Aggregations holds the buckets for each aggregation requested in
+	// SearchStreamsOptions.Aggregations, largest count first.
+	Aggregations map[SearchAggregation][]AggregationBucket `json:"aggregations,omitempty"`
+
+	// Suggestions are corrected spellings of the query, best first.
+	Suggestions []string `json:"suggestions,omitempty"`
+
+	// CorrectedQuery is set when the client was created with
+	// WithSearchAutoCorrect and the results are for this suggestion rather
+	// than the original query.
+	CorrectedQuery string `json:"-"`
 }
 
-// TranscriptSegment represents a single transcript segment.
+// SearchSuggestionsResponse is the response for search term suggestions.
+type SearchSuggestionsResponse struct {
+	Suggestions []string `json:"suggestions"`
+}
+
+// TranscriptSegment represents a single transcript segment. Speaker labels
+// who is talking (e.g. "SPEAKER_1") when the transcript was requested with
+// TranscriptOptions.Diarize and diarization is available for the stream.
 type TranscriptSegment struct {
-	Start float64 `json:"start"`
-	End   float64 `json:"end"`
-	Text  string  `json:"text"`
+	Start   float64 `json:"start"`
+	End     float64 `json:"end"`
+	Text    string  `json:"text"`
+	Speaker string  `json:"speaker,omitempty"`
+}
+
+// TranscriptOptions contains options for retrieving a stream transcript.
+type TranscriptOptions struct {
+	// Diarize requests speaker labels on each segment, where available.
+	Diarize bool
+
+	// Translate requests the transcript translated into the given language,
+	// as an ISO 639-1 code such as "en".
+	Translate string
 }
 
 // TranscriptResponse is the response for getting a stream transcript.
+// Language is the ISO 639-1 code of the segment text, which is the requested
+// language for translated transcripts.
 type TranscriptResponse struct {
+	Language string              `json:"language,omitempty"`
 	Segments []TranscriptSegment `json:"segments"`
 }
 
+// ProcessingState is the state of a stream's transcript processing.
+type ProcessingState string
+
+// Processing states. A partial transcript covers the stream so far and grows
+// while the stream is live or processing catches up.
+const (
+	ProcessingPending  ProcessingState = "pending"
+	ProcessingPartial  ProcessingState = "partial"
+	ProcessingComplete ProcessingState = "complete"
+	ProcessingFailed   ProcessingState = "failed"
+)
+
+// StreamProcessingStatus reports how far a stream's transcript has been
+// processed. ModelVersion names the ASR model that produced the transcript.
+type StreamProcessingStatus struct {
+	StreamID     string          `json:"stream_id"`
+	State        ProcessingState `json:"state"`
+	ModelVersion string          `json:"model_version,omitempty"`
+	Error        string          `json:"error,omitempty"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+// StreamStats holds viewership and chat activity for a stream. For a live
+// stream the figures cover the stream so far.
+type StreamStats struct {
+	StreamID              string  `json:"stream_id"`
+	AverageViewers        float64 `json:"average_viewers"`
+	PeakViewers           int     `json:"peak_viewers"`
+	ChatMessages          int     `json:"chat_messages"`
+	UniqueChatters        int     `json:"unique_chatters"`
+	ChatMessagesPerMinute float64 `json:"chat_messages_per_minute"`
+}
+
+// GetStreamStatsResponse is the response for getting a stream's stats.
+type GetStreamStatsResponse struct {
+	Stats StreamStats `json:"stats"`
+}
+
+// TopicKind distinguishes keywords from named entities in a stream's topics.
+type TopicKind string
+
+// Topic kinds.
+const (
+	TopicKeyword TopicKind = "keyword"
+	TopicEntity  TopicKind = "entity"
+)
+
+// StreamTopic is a topic extracted from a stream's transcript. Score is the
+// topic's relevance between 0 and 1.
+type StreamTopic struct {
+	Name     string    `json:"name"`
+	Kind     TopicKind `json:"kind"`
+	Score    float64   `json:"score"`
+	Mentions int       `json:"mentions"`
+}
+
+// StreamTopicsResponse is the response for getting a stream's topics, ranked
+// by score, highest first.
+type StreamTopicsResponse struct {
+	Topics []StreamTopic `json:"topics"`
+}
+
+// Category is a stream category. StreamCount is the number of streams
+// recorded in the category.
+type Category struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	StreamCount int    `json:"stream_count"`
+}
+
+// ListCategoriesResponse is the response for listing categories.
+type ListCategoriesResponse struct {
+	Categories []Category `json:"categories"`
+}
+
+// StreamChapter is a part of a stream during which its title and category
+// stayed the same. Start and End are offsets in seconds from the start of the
+// stream, like those of TranscriptSegment. End is zero for the current
+// chapter of a live stream.
+type StreamChapter struct {
+	Title    string  `json:"title"`
+	Category string  `json:"category,omitempty"`
+	Start    float64 `json:"start"`
+	End      float64 `json:"end,omitempty"`
+}
+
+// StreamChaptersResponse is the response for getting a stream's chapters.
+type StreamChaptersResponse struct {
+	Chapters []StreamChapter `json:"chapters"`
+}
+
+// At returns the chapter containing offset, or nil if there is none.
+func (r *StreamChaptersResponse) At(offset time.Duration) *StreamChapter {
+	secs := offset.Seconds()
+	for i := range r.Chapters {
+		ch := &r.Chapters[i]
+		if secs >= ch.Start && (ch.End == 0 || secs < ch.End) {
+			return ch
+		}
+	}
+	return nil
+}
+
+// ChatMessage is a chat message sent during a stream. Offset is the number of
+// seconds from the start of the stream, like TranscriptSegment.Start.
+type ChatMessage struct {
+	ID          string    `json:"id"`
+	UserID      string    `json:"user_id"`
+	UserLogin   string    `json:"user_login"`
+	DisplayName string    `json:"display_name,omitempty"`
+	Text        string    `json:"text"`
+	Offset      float64   `json:"offset"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
+// StreamChatOptions contains options for retrieving a stream's chat log.
+type StreamChatOptions struct {
+	Page     int
+	PageSize int
+
+	// From and To restrict messages to offsets in [From, To) from the start
+	// of the stream. Zero values are not sent.
+	From time.Duration
+	To   time.Duration
+}
+
+// StreamChatResponse is the response for getting a stream's chat log.
+type StreamChatResponse struct {
+	Messages   []ChatMessage `json:"messages"`
+	Pagination Pagination    `json:"pagination"`
+}
+
 // Streamer represents a streamer profile.
 type Streamer struct {
 	ID              string    `json:"id"`
@@ -171,6 +891,34 @@ type Streamer struct {
 	FetchedAt       time.Time `json:"fetched_at"`
 }
 
+// Twitch broadcaster types, as found in Streamer.BroadcasterType. Streamers
+// who are neither partners nor affiliates have an empty broadcaster type.
+const (
+	BroadcasterPartner   = "partner"
+	BroadcasterAffiliate = "affiliate"
+)
+
+// ListStreamersOptions contains options for listing streamers.
+type ListStreamersOptions struct {
+	Page     int
+	PageSize int
+
+	// Filters. Zero values are not sent.
+	BroadcasterType string
+	MinFollowers    int
+}
+
+// ListStreamersResponse is the response for listing streamers.
+type ListStreamersResponse struct {
+	Streamers  []Streamer `json:"streamers"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// TrackStreamerRequest is the request body for tracking a streamer.
+type TrackStreamerRequest struct {
+	StreamerID string `json:"streamer_id"`
+}
+
 // BillingSummary contains billing information for Enterprise users.
 type BillingSummary struct {
 	UserID             string    `json:"user_id"`
@@ -194,14 +942,159 @@ type MonthlyUsageResponse struct {
 	Subscription   Subscription   `json:"subscription"`
 }
 
+// AlertLimits describes the alert quotas for the account's subscription tier
+// and how much of them is in use.
+type AlertLimits struct {
+	Tier               string `json:"tier"`
+	MaxAlerts          int    `json:"max_alerts"`
+	MaxActiveAlerts    int    `json:"max_active_alerts"`
+	MaxPhrasesPerAlert int    `json:"max_phrases_per_alert"`
+	AlertsUsed         int    `json:"alerts_used"`
+	ActiveAlertsUsed   int    `json:"active_alerts_used"`
+}
+
+// RemainingAlerts returns how many more alerts can be created.
+func (l *AlertLimits) RemainingAlerts() int {
+	return max(l.MaxAlerts-l.AlertsUsed, 0)
+}
+
+// RemainingActiveAlerts returns how many more alerts can be active at once.
+func (l *AlertLimits) RemainingActiveAlerts() int {
+	return max(l.MaxActiveAlerts-l.ActiveAlertsUsed, 0)
+}
+
+// SigningKey is a public key used to sign webhook deliveries.
+// PublicKey is the base64-encoded DER (PKIX) public key.
+type SigningKey struct {
+	ID        string    `json:"id"`
+	Algorithm string    `json:"algorithm"`
+	PublicKey string    `json:"public_key"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at,omitzero"`
+}
+
+// ListSigningKeysResponse is the response for listing webhook signing keys.
+type ListSigningKeysResponse struct {
+	Keys []SigningKey `json:"keys"`
+}
+
+// Webhook payload types.
+const (
+	WebhookPayloadTypeNotification = "notification"
+	WebhookPayloadTypeDigest       = "digest"
+)
+
+// DigestConfig batches an alert's matches into periodic webhook deliveries
+// instead of sending one webhook per match.
+type DigestConfig struct {
+	// Interval is how often a digest is sent. The API works in whole seconds.
+	Interval time.Duration
+	// MaxItems caps the notifications included in one digest. Zero uses the
+	// API default.
+	MaxItems int
+}
+
+type digestConfigJSON struct {
+	IntervalSeconds int64 `json:"interval_seconds"`
+	MaxItems        int   `json:"max_items,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d DigestConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(digestConfigJSON{
+		IntervalSeconds: int64(d.Interval / time.Second),
+		MaxItems:        d.MaxItems,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DigestConfig) UnmarshalJSON(data []byte) error {
+	var v digestConfigJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	d.Interval = time.Duration(v.IntervalSeconds) * time.Second
+	d.MaxItems = v.MaxItems
+	return nil
+}
+
+// WebhookNotificationDigest is the payload sent for alerts with a digest
+// configuration, containing every match in the digest period.
+type WebhookNotificationDigest struct {
+	ID            string                `json:"id"`
+	Type          string                `json:"type"`
+	AlertID       string                `json:"alert_id"`
+	PeriodStart   time.Time             `json:"period_start"`
+	PeriodEnd     time.Time             `json:"period_end"`
+	TotalMatches  int                   `json:"total_matches"`
+	Notifications []WebhookNotification `json:"notifications"`
+}
+
+// Truncated reports whether the digest omitted matches because of MaxItems.
+func (d *WebhookNotificationDigest) Truncated() bool {
+	return d.TotalMatches > len(d.Notifications)
+}
+
+// WebhookEvent is the payload for webhook events other than notifications,
+// such as EventAlertUpdated and EventStreamProcessed. Data holds the
+// event-specific body, e.g. the updated Alert or the processed Stream.
+type WebhookEvent struct {
+	ID        string          `json:"id"`
+	Event     EventType       `json:"event"`
+	AlertID   string          `json:"alert_id,omitempty"`
+	StreamID  string          `json:"stream_id,omitempty"`
+	Timestamp time.Time       `json:"timestamp"`
+	Data      json.RawMessage `json:"data,omitempty"`
+
+	// Raw is the JSON this event was parsed from.
+	Raw json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping a copy of data in Raw.
+func (e *WebhookEvent) UnmarshalJSON(data []byte) error {
+	type plain WebhookEvent
+	if err := json.Unmarshal(data, (*plain)(e)); err != nil {
+		return err
+	}
+	e.Raw = bytes.Clone(data)
+	return nil
+}
+
+// Notification decodes the event as a notification, for
+// EventNotificationCreated deliveries.
+func (e *WebhookEvent) Notification() (*WebhookNotification, error) {
+	return ParseWebhookNotification(e.Raw)
+}
+
+// Digest decodes the event as a digest, for EventNotificationDigest deliveries.
+func (e *WebhookEvent) Digest() (*WebhookNotificationDigest, error) {
+	return ParseWebhookNotificationDigest(e.Raw)
+}
+
 // WebhookNotification is the payload received from core.stream webhooks.
 type WebhookNotification struct {
 	ID             string    `json:"id"`
+	Event          EventType `json:"event,omitempty"`
 	AlertID        string    `json:"alert_id"`
 	StreamID       string    `json:"stream_id,omitempty"`
 	StreamerID     string    `json:"streamer_id,omitempty"`
 	MatchedPhrase  string    `json:"matched_phrase"`
+	Priority       Priority  `json:"priority,omitempty"`
 	ContextText    string    `json:"context_text,omitempty"`
 	Timestamp      time.Time `json:"timestamp"`
 	FullTranscript string    `json:"full_transcript,omitempty"`
+
+	// Raw is the JSON this notification was parsed from. For notifications
+	// delivered on their own it is the signed request body.
+	Raw json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping a copy of data in Raw.
+func (n *WebhookNotification) UnmarshalJSON(data []byte) error {
+	type plain WebhookNotification
+	if err := json.Unmarshal(data, (*plain)(n)); err != nil {
+		return err
+	}
+	n.Raw = bytes.Clone(data)
+	return nil
 }
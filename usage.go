@@ -14,3 +14,14 @@ func (c *Client) GetMonthlyUsage(ctx context.Context) (*MonthlyUsageResponse, er
 	}
 	return &resp, nil
 }
+
+// GetAlertLimits retrieves the alert quotas for the account's subscription
+// tier along with current usage, so callers can check capacity before
+// creating alerts.
+func (c *Client) GetAlertLimits(ctx context.Context) (*AlertLimits, error) {
+	var limits AlertLimits
+	if err := c.request(ctx, http.MethodGet, "/v2/usage/alert-limits", nil, nil, &limits); err != nil {
+		return nil, err
+	}
+	return &limits, nil
+}
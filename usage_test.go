@@ -72,3 +72,34 @@ func TestGetMonthlyUsage(t *testing.T) {
 		}
 	})
 }
+
+func TestGetAlertLimits(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/usage/alert-limits" {
+			t.Errorf("expected path /v2/usage/alert-limits, got %s", r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(AlertLimits{
+			Tier:               "pro",
+			MaxAlerts:          50,
+			MaxActiveAlerts:    25,
+			MaxPhrasesPerAlert: 20,
+			AlertsUsed:         48,
+			ActiveAlertsUsed:   30,
+		})
+	})
+	defer server.Close()
+
+	limits, err := client.GetAlertLimits(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if limits.MaxPhrasesPerAlert != 20 {
+		t.Errorf("expected 20 phrases per alert, got %d", limits.MaxPhrasesPerAlert)
+	}
+	if got := limits.RemainingAlerts(); got != 2 {
+		t.Errorf("expected 2 remaining alerts, got %d", got)
+	}
+	if got := limits.RemainingActiveAlerts(); got != 0 {
+		t.Errorf("expected 0 remaining active alerts, got %d", got)
+	}
+}
@@ -0,0 +1,268 @@
+package corestream
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Alert phrase limits enforced by the API.
+const (
+	MinPhraseLength    = 2
+	MaxPhraseLength    = 100
+	MaxPhrasesPerAlert = 100
+	MaxFuzzyDistance   = 2
+)
+
+// MinDigestInterval is the shortest digest interval the API accepts.
+const MinDigestInterval = time.Minute
+
+// disallowedPhraseChars are rejected by the API in alert phrases.
+const disallowedPhraseChars = "<>{}|\\"
+
+// ValidationError describes an invalid field in a request.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("corestream: %s: %s", e.Field, e.Message)
+}
+
+// ValidationErrors is a list of field-level validation errors.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = fmt.Sprintf("%s: %s", err.Field, err.Message)
+	}
+	return "corestream: invalid request: " + strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the individual errors so errors.As can find a ValidationError.
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// validator accumulates field-level validation errors.
+type validator struct {
+	errs ValidationErrors
+}
+
+func (v *validator) addf(field, format string, args ...any) {
+	v.errs = append(v.errs, &ValidationError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+// ValidatePhrasePatterns checks that each pattern is a valid regular
+// expression (RE2 syntax, as used by the API) within the API's limits.
+func ValidatePhrasePatterns(patterns []string) error {
+	var v validator
+	v.patterns("phrase_patterns", patterns)
+	return v.err()
+}
+
+func (v *validator) patterns(field string, patterns []string) {
+	if len(patterns) > MaxPhrasesPerAlert {
+		v.addf(field, "at most %d patterns are allowed, got %d", MaxPhrasesPerAlert, len(patterns))
+	}
+	for i, pattern := range patterns {
+		f := fmt.Sprintf("%s[%d]", field, i)
+		if utf8.RuneCountInString(pattern) > MaxPhraseLength {
+			v.addf(f, "must be at most %d characters", MaxPhraseLength)
+			continue
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			v.addf(f, "invalid regular expression: %v", err)
+			continue
+		}
+		if re.MatchString("") {
+			v.addf(f, "must not match empty text")
+		}
+	}
+}
+
+func (v *validator) matchOptions(field string, opts *MatchOptions) {
+	if opts == nil {
+		return
+	}
+	if opts.FuzzyDistance < 0 || opts.FuzzyDistance > MaxFuzzyDistance {
+		v.addf(field+".fuzzy_distance", "must be between 0 and %d", MaxFuzzyDistance)
+	}
+}
+
+func (v *validator) streamerScope(include, exclude []string) {
+	included := make(map[string]bool, len(include))
+	for _, id := range include {
+		included[id] = true
+	}
+	for i, id := range exclude {
+		if included[id] {
+			v.addf(fmt.Sprintf("exclude_streamer_ids[%d]", i), "streamer %s is also in streamer_ids", id)
+		}
+	}
+}
+
+func (v *validator) categories(field string, categories []string) {
+	for i, category := range categories {
+		if strings.TrimSpace(category) == "" {
+			v.addf(fmt.Sprintf("%s[%d]", field, i), "cannot be empty")
+		}
+	}
+}
+
+func (v *validator) schedule(field string, s *Schedule) {
+	if s == nil {
+		return
+	}
+	if err := s.Validate(); err != nil {
+		v.addf(field, "%s", strings.TrimPrefix(err.Error(), "corestream: "))
+	}
+}
+
+func (v *validator) digest(field string, d *DigestConfig) {
+	if d == nil {
+		return
+	}
+	if d.Interval < MinDigestInterval {
+		v.addf(field+".interval", "must be at least %s", MinDigestInterval)
+	}
+	if d.MaxItems < 0 {
+		v.addf(field+".max_items", "cannot be negative")
+	}
+}
+
+func (v *validator) priority(field string, p Priority) {
+	if p != "" && !p.Valid() {
+		v.addf(field, "must be one of %q, %q, or %q", PriorityLow, PriorityMedium, PriorityHigh)
+	}
+}
+
+// ValidateAlertPhrases checks phrases against the API's rules so invalid
+// alerts can be rejected before a request is made. The returned error is a
+// ValidationErrors listing every invalid phrase. Phrases are compared
+// case-insensitively when checking for duplicates, as the default
+// MatchOptions match them.
+func ValidateAlertPhrases(phrases []string) error {
+	var v validator
+	v.phrases("phrases", phrases, true, false)
+	return v.err()
+}
+
+// phrases checks a list of alert phrases. Phrases differing only in case are
+// duplicates unless caseSensitive is set.
+func (v *validator) phrases(field string, phrases []string, required, caseSensitive bool) {
+	if required && len(phrases) == 0 {
+		v.addf(field, "at least one phrase is required")
+		return
+	}
+	if len(phrases) > MaxPhrasesPerAlert {
+		v.addf(field, "at most %d phrases are allowed, got %d", MaxPhrasesPerAlert, len(phrases))
+	}
+
+	seen := make(map[string]int, len(phrases))
+	for i, phrase := range phrases {
+		f := fmt.Sprintf("%s[%d]", field, i)
+		trimmed := strings.TrimSpace(phrase)
+		n := utf8.RuneCountInString(trimmed)
+		switch {
+		case n < MinPhraseLength:
+			v.addf(f, "must be at least %d characters", MinPhraseLength)
+			continue
+		case n > MaxPhraseLength:
+			v.addf(f, "must be at most %d characters", MaxPhraseLength)
+			continue
+		}
+		if i := strings.IndexFunc(trimmed, func(r rune) bool {
+			return unicode.IsControl(r) || strings.ContainsRune(disallowedPhraseChars, r)
+		}); i >= 0 {
+			r, _ := utf8.DecodeRuneInString(trimmed[i:])
+			v.addf(f, "contains disallowed character %q", r)
+			continue
+		}
+		key := trimmed
+		if !caseSensitive {
+			key = strings.ToLower(trimmed)
+		}
+		if prev, ok := seen[key]; ok {
+			v.addf(f, "duplicates %s[%d]", field, prev)
+			continue
+		}
+		seen[key] = i
+	}
+}
+
+// Validate checks the request against the API's rules.
+func (r *CreateAlertRequest) Validate() error {
+	var v validator
+	if strings.TrimSpace(r.Name) == "" {
+		v.addf("name", "is required")
+	}
+	caseSensitive := r.MatchOptions != nil && r.MatchOptions.CaseSensitive
+	v.phrases("phrases", r.Phrases, len(r.PhrasePatterns) == 0, caseSensitive)
+	v.patterns("phrase_patterns", r.PhrasePatterns)
+	v.phrases("exclude_phrases", r.ExcludePhrases, false, caseSensitive)
+	v.matchOptions("match_options", r.MatchOptions)
+	v.streamerScope(r.StreamerIDs, r.ExcludeStreamerIDs)
+	v.categories("categories", r.Categories)
+	v.schedule("schedule", r.Schedule)
+	v.digest("digest", r.Digest)
+	v.priority("priority", r.Priority)
+	return v.err()
+}
+
+// Validate checks the request against the API's rules.
+func (r *UpdateAlertRequest) Validate() error {
+	var v validator
+	if r.Name != nil && strings.TrimSpace(*r.Name) == "" {
+		v.addf("name", "cannot be empty")
+	}
+	caseSensitive := r.MatchOptions != nil && r.MatchOptions.CaseSensitive
+	if r.Phrases != nil {
+		v.phrases("phrases", r.Phrases, len(r.PhrasePatterns) == 0, caseSensitive)
+	}
+	v.patterns("phrase_patterns", r.PhrasePatterns)
+	v.phrases("exclude_phrases", r.ExcludePhrases, false, caseSensitive)
+	v.matchOptions("match_options", r.MatchOptions)
+	v.streamerScope(r.StreamerIDs, r.ExcludeStreamerIDs)
+	v.categories("categories", r.Categories)
+	v.schedule("schedule", r.Schedule)
+	v.digest("digest", r.Digest)
+	v.priority("priority", r.Priority)
+	return v.err()
+}
+
+// Validate checks that the notification has the fields every notification
+// carries: ID, AlertID, and Timestamp. The error wraps ErrInvalidNotification.
+func (n *WebhookNotification) Validate() error {
+	var missing []string
+	if n.ID == "" {
+		missing = append(missing, "id")
+	}
+	if n.AlertID == "" {
+		missing = append(missing, "alert_id")
+	}
+	if n.Timestamp.IsZero() {
+		missing = append(missing, "timestamp")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: missing %s", ErrInvalidNotification, strings.Join(missing, ", "))
+	}
+	return nil
+}
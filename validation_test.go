@@ -0,0 +1,232 @@
+package corestream
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateAlertPhrases(t *testing.T) {
+	tests := []struct {
+		name       string
+		phrases    []string
+		wantFields []string
+	}{
+		{"valid", []string{"our product", "acme"}, nil},
+		{"empty list", nil, []string{"phrases"}},
+		{"too short", []string{"a"}, []string{"phrases[0]"}},
+		{"too long", []string{"ok", strings.Repeat("x", MaxPhraseLength+1)}, []string{"phrases[1]"}},
+		{"disallowed character", []string{"<script>"}, []string{"phrases[0]"}},
+		{"control character", []string{"line\nbreak"}, []string{"phrases[0]"}},
+		{"duplicate", []string{"Acme", "acme "}, []string{"phrases[1]"}},
+		{"multiple errors", []string{"a", "fine", "b"}, []string{"phrases[0]", "phrases[2]"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAlertPhrases(tt.phrases)
+			if tt.wantFields == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var verrs ValidationErrors
+			if !errors.As(err, &verrs) {
+				t.Fatalf("expected ValidationErrors, got %v", err)
+			}
+			if len(verrs) != len(tt.wantFields) {
+				t.Fatalf("expected %d errors, got %v", len(tt.wantFields), verrs)
+			}
+			for i, field := range tt.wantFields {
+				if verrs[i].Field != field {
+					t.Errorf("expected field %s, got %s", field, verrs[i].Field)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateAlertPhrases_TooMany(t *testing.T) {
+	phrases := make([]string, MaxPhrasesPerAlert+1)
+	for i := range phrases {
+		phrases[i] = fmt.Sprintf("phrase %d", i)
+	}
+
+	var verrs ValidationErrors
+	if !errors.As(ValidateAlertPhrases(phrases), &verrs) || len(verrs) != 1 || verrs[0].Field != "phrases" {
+		t.Errorf("expected a single count error, got %v", verrs)
+	}
+}
+
+func TestCreateAlertRequest_Validate(t *testing.T) {
+	err := (&CreateAlertRequest{Phrases: []string{"x"}}).Validate()
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Field != "name" {
+		t.Errorf("expected name validation error, got %v", err)
+	}
+
+	if err := (&CreateAlertRequest{Name: "Brand", Phrases: []string{"acme"}}).Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestUpdateAlertRequest_Validate(t *testing.T) {
+	active := true
+	if err := (&UpdateAlertRequest{IsActive: &active}).Validate(); err != nil {
+		t.Errorf("unexpected error for partial update: %v", err)
+	}
+	if err := (&UpdateAlertRequest{Phrases: []string{}}).Validate(); err == nil {
+		t.Error("expected error for empty phrase list")
+	}
+}
+
+func TestValidatePhrasePatterns(t *testing.T) {
+	if err := ValidatePhrasePatterns([]string{`ACME-\d{4}`, `(?i)core\.stream`}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var verrs ValidationErrors
+	err := ValidatePhrasePatterns([]string{`ACME-\d{4}`, `ACME-(\d+`, `.*`})
+	if !errors.As(err, &verrs) {
+		t.Fatalf("expected ValidationErrors, got %v", err)
+	}
+	if len(verrs) != 2 || verrs[0].Field != "phrase_patterns[1]" || verrs[1].Field != "phrase_patterns[2]" {
+		t.Errorf("unexpected errors %v", verrs)
+	}
+}
+
+func TestCreateAlertRequest_Validate_PatternsOnly(t *testing.T) {
+	req := &CreateAlertRequest{Name: "SKUs", PhrasePatterns: []string{`ACME-\d{4}`}}
+	if err := req.Validate(); err != nil {
+		t.Errorf("expected patterns to satisfy the phrase requirement, got %v", err)
+	}
+}
+
+func TestCreateAlertRequest_Validate_ExcludePhrases(t *testing.T) {
+	req := &CreateAlertRequest{Name: "Brand", Phrases: []string{"acme"}, ExcludePhrases: []string{"x"}}
+
+	var verr *ValidationError
+	if !errors.As(req.Validate(), &verr) || verr.Field != "exclude_phrases[0]" {
+		t.Errorf("expected exclude_phrases[0] error, got %v", req.Validate())
+	}
+}
+
+func TestCreateAlertRequest_Validate_MatchOptions(t *testing.T) {
+	req := &CreateAlertRequest{
+		Name:         "Go",
+		Phrases:      []string{"Go"},
+		MatchOptions: &MatchOptions{CaseSensitive: true, WholeWord: true, FuzzyDistance: MaxFuzzyDistance + 1},
+	}
+
+	var verr *ValidationError
+	if !errors.As(req.Validate(), &verr) || verr.Field != "match_options.fuzzy_distance" {
+		t.Errorf("expected match_options.fuzzy_distance error, got %v", req.Validate())
+	}
+
+	req.MatchOptions.FuzzyDistance = 1
+	if err := req.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCreateAlertRequest_Validate_CaseSensitiveDuplicates(t *testing.T) {
+	req := &CreateAlertRequest{Name: "Go", Phrases: []string{"Go", "GO"}}
+	var verr *ValidationError
+	if !errors.As(req.Validate(), &verr) || verr.Field != "phrases[1]" {
+		t.Errorf("expected phrases[1] duplicate error, got %v", req.Validate())
+	}
+
+	req.MatchOptions = &MatchOptions{CaseSensitive: true}
+	if err := req.Validate(); err != nil {
+		t.Errorf("unexpected error for case-sensitive phrases: %v", err)
+	}
+	req.Phrases = []string{"Go", "Go"}
+	if err := req.Validate(); err == nil {
+		t.Error("expected error for exact duplicate phrases")
+	}
+
+	update := &UpdateAlertRequest{Phrases: []string{"Go", "GO"}, MatchOptions: &MatchOptions{CaseSensitive: true}}
+	if err := update.Validate(); err != nil {
+		t.Errorf("unexpected error for case-sensitive update: %v", err)
+	}
+}
+
+func TestCreateAlertRequest_Validate_StreamerScope(t *testing.T) {
+	req := &CreateAlertRequest{
+		Name:               "Roster",
+		Phrases:            []string{"acme"},
+		StreamerIDs:        []string{"streamer_1", "streamer_2"},
+		ExcludeStreamerIDs: []string{"streamer_3", "streamer_2"},
+	}
+
+	var verr *ValidationError
+	if !errors.As(req.Validate(), &verr) || verr.Field != "exclude_streamer_ids[1]" {
+		t.Errorf("expected exclude_streamer_ids[1] error, got %v", req.Validate())
+	}
+}
+
+func TestCreateAlertRequest_Validate_Categories(t *testing.T) {
+	req := &CreateAlertRequest{Name: "Chatting", Phrases: []string{"acme"}, Categories: []string{"Just Chatting", " "}}
+
+	var verr *ValidationError
+	if !errors.As(req.Validate(), &verr) || verr.Field != "categories[1]" {
+		t.Errorf("expected categories[1] error, got %v", req.Validate())
+	}
+}
+
+func TestCreateAlertRequest_Validate_Schedule(t *testing.T) {
+	req := &CreateAlertRequest{Name: "On call", Phrases: []string{"acme"}, Schedule: &Schedule{Days: Weekdays, Timezone: "Nowhere/City"}}
+
+	var verr *ValidationError
+	if !errors.As(req.Validate(), &verr) || verr.Field != "schedule" {
+		t.Errorf("expected schedule error, got %v", req.Validate())
+	}
+}
+
+func TestCreateAlertRequest_Validate_Digest(t *testing.T) {
+	req := &CreateAlertRequest{Name: "Hourly", Phrases: []string{"acme"}, Digest: &DigestConfig{Interval: 30 * time.Second}}
+
+	var verr *ValidationError
+	if !errors.As(req.Validate(), &verr) || verr.Field != "digest.interval" {
+		t.Errorf("expected digest.interval error, got %v", req.Validate())
+	}
+
+	req.Digest.Interval = time.Hour
+	if err := req.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCreateAlertRequest_Validate_Priority(t *testing.T) {
+	req := &CreateAlertRequest{Name: "Urgent", Phrases: []string{"acme"}, Priority: "critical"}
+
+	var verr *ValidationError
+	if !errors.As(req.Validate(), &verr) || verr.Field != "priority" {
+		t.Errorf("expected priority error, got %v", req.Validate())
+	}
+
+	req.Priority = PriorityHigh
+	if err := req.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestWebhookNotification_Validate(t *testing.T) {
+	valid := WebhookNotification{ID: "n1", AlertID: "a1", Timestamp: time.Now()}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("expected valid notification, got %v", err)
+	}
+
+	err := (&WebhookNotification{ID: "n1"}).Validate()
+	if !errors.Is(err, ErrInvalidNotification) {
+		t.Fatalf("expected ErrInvalidNotification, got %v", err)
+	}
+	if want := "corestream: invalid webhook notification: missing alert_id, timestamp"; err.Error() != want {
+		t.Errorf("expected %q, got %q", want, err.Error())
+	}
+}
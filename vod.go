@@ -0,0 +1,62 @@
+package corestream
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const twitchVideoURL = "https://twitch.tv/videos/"
+
+// VODLinkAt returns a link to the stream's VOD starting at offset from the
+// start of the stream, e.g. "https://twitch.tv/videos/123?t=1h2m3s". It
+// returns "" if the stream has no VOD.
+func (s *Stream) VODLinkAt(offset time.Duration) string {
+	if s.VodID == "" {
+		return ""
+	}
+	return twitchVideoURL + url.PathEscape(s.VodID) + "?t=" + twitchTimestamp(offset)
+}
+
+// VODLinkAtTime returns a link to the stream's VOD at wall-clock time t, such
+// as a notification's Timestamp. It returns "" if the stream has no VOD.
+func (s *Stream) VODLinkAtTime(t time.Time) string {
+	return s.VODLinkAt(t.Sub(s.StartedAt))
+}
+
+// VODLink fetches a stream and returns a link to its VOD at time at. It
+// returns "" if the stream has no VOD. For webhook notifications, pass the
+// notification's StreamID and Timestamp.
+func (c *Client) VODLink(ctx context.Context, streamID string, at time.Time) (string, error) {
+	if streamID == "" {
+		return "", fmt.Errorf("corestream: stream ID is required")
+	}
+	stream, err := c.GetStream(ctx, streamID)
+	if err != nil {
+		return "", err
+	}
+	return stream.VODLinkAtTime(at), nil
+}
+
+// NotificationVODLink returns a link to the moment of a notification's match
+// in its stream's VOD. It returns "" if the stream has no VOD.
+func (c *Client) NotificationVODLink(ctx context.Context, n *Notification) (string, error) {
+	return c.VODLink(ctx, n.StreamID, n.Timestamp)
+}
+
+// twitchTimestamp formats d as a Twitch "t" parameter, e.g. "1h2m3s".
+func twitchTimestamp(d time.Duration) string {
+	secs := int64(max(d, 0) / time.Second)
+	h, m, s := secs/3600, secs/60%60, secs%60
+	var b strings.Builder
+	if h > 0 {
+		fmt.Fprintf(&b, "%dh", h)
+	}
+	if h > 0 || m > 0 {
+		fmt.Fprintf(&b, "%dm", m)
+	}
+	fmt.Fprintf(&b, "%ds", s)
+	return b.String()
+}
@@ -0,0 +1,63 @@
+package corestream
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestStream_VODLinkAt(t *testing.T) {
+	s := &Stream{VodID: "123456"}
+	tests := []struct {
+		offset time.Duration
+		want   string
+	}{
+		{0, "https://twitch.tv/videos/123456?t=0s"},
+		{-time.Minute, "https://twitch.tv/videos/123456?t=0s"},
+		{42 * time.Second, "https://twitch.tv/videos/123456?t=42s"},
+		{5*time.Minute + 900*time.Millisecond, "https://twitch.tv/videos/123456?t=5m0s"},
+		{time.Hour + 2*time.Minute + 3*time.Second, "https://twitch.tv/videos/123456?t=1h2m3s"},
+		{10*time.Hour + 5*time.Second, "https://twitch.tv/videos/123456?t=10h0m5s"},
+	}
+	for _, tt := range tests {
+		if got := s.VODLinkAt(tt.offset); got != tt.want {
+			t.Errorf("VODLinkAt(%v) = %s, want %s", tt.offset, got, tt.want)
+		}
+	}
+
+	if got := (&Stream{}).VODLinkAt(time.Minute); got != "" {
+		t.Errorf("expected no link without a VOD, got %s", got)
+	}
+}
+
+func TestStream_VODLinkAtTime(t *testing.T) {
+	start := time.Date(2024, 3, 1, 20, 0, 0, 0, time.UTC)
+	s := &Stream{VodID: "123456", StartedAt: start}
+	if got, want := s.VODLinkAtTime(start.Add(90*time.Minute)), "https://twitch.tv/videos/123456?t=1h30m0s"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestNotificationVODLink(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/streams/stream_1" {
+			t.Errorf("expected path /v2/streams/stream_1, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"stream":{"id":"stream_1","vod_id":"987","started_at":"2024-03-01T20:00:00Z"}}`))
+	})
+	defer server.Close()
+
+	n := &Notification{StreamID: "stream_1", Timestamp: time.Date(2024, 3, 1, 21, 2, 3, 0, time.UTC)}
+	link, err := client.NotificationVODLink(context.Background(), n)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "https://twitch.tv/videos/987?t=1h2m3s"; link != want {
+		t.Errorf("expected %s, got %s", want, link)
+	}
+
+	if _, err := client.NotificationVODLink(context.Background(), &Notification{}); err == nil {
+		t.Error("expected an error for a notification without a stream")
+	}
+}
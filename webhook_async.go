@@ -0,0 +1,244 @@
+package corestream
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// OverflowPolicy decides what an asynchronous WebhookReceiver does with a
+// delivery when its queue is full.
+type OverflowPolicy int
+
+const (
+	// OverflowReject responds 503 Service Unavailable so the sender retries later.
+	OverflowReject OverflowPolicy = iota
+	// OverflowBlock waits for queue space, holding the request open.
+	OverflowBlock
+	// OverflowDrop acknowledges the delivery and discards it, reporting
+	// ErrWebhookQueueFull to the async error handler.
+	OverflowDrop
+)
+
+// WithAsyncProcessing makes the receiver acknowledge verified deliveries
+// immediately and process them on a pool of workers goroutines, with up to
+// queueSize deliveries waiting. Queued deliveries get the usual success
+// response, or 202 Accepted with WithAcceptedResponse. Handler errors can no
+// longer affect the response; use WithAsyncErrorHandler to observe them. Call
+// Shutdown to drain the queue when the server stops.
+func WithAsyncProcessing(workers, queueSize int) WebhookReceiverOption {
+	return func(r *WebhookReceiver) {
+		r.async = &asyncProcessor{
+			workers: max(workers, 1),
+			queue:   make(chan asyncJob, max(queueSize, 0)),
+			stop:    make(chan struct{}),
+		}
+	}
+}
+
+// WithOverflowPolicy sets what happens when the async queue is full.
+// The default is OverflowReject. It has no effect without WithAsyncProcessing.
+func WithOverflowPolicy(policy OverflowPolicy) WebhookReceiverOption {
+	return func(r *WebhookReceiver) {
+		r.overflowPolicy = policy
+	}
+}
+
+// WithAsyncErrorHandler sets a function called with errors from asynchronously
+// processed deliveries. It may be called from several goroutines at once.
+func WithAsyncErrorHandler(fn func(err error)) WebhookReceiverOption {
+	return func(r *WebhookReceiver) {
+		r.asyncErrorFunc = fn
+	}
+}
+
+type asyncJob struct {
+	ctx     context.Context
+	body    []byte
+	storeID string
+}
+
+type asyncProcessor struct {
+	workers int
+	queue   chan asyncJob
+	wg      sync.WaitGroup
+
+	// mu guards closed and is held for reading while enqueuing, so Shutdown
+	// never closes the queue under a pending send.
+	mu     sync.RWMutex
+	closed bool
+
+	// stop is closed when Shutdown gives up waiting, so that workers leave
+	// the remaining queue to be reported instead of processed.
+	stop     chan struct{}
+	stopOnce sync.Once
+	inFlight atomic.Int32
+
+	// handoff is held for reading by workers from taking a job off the queue
+	// until it is counted in flight or set aside in unprocessed, so that
+	// Shutdown can account for every job once it holds the write lock.
+	handoff       sync.RWMutex
+	unprocessedMu sync.Mutex
+	unprocessed   [][]byte
+}
+
+func (r *WebhookReceiver) startWorkers() {
+	for range r.async.workers {
+		r.async.wg.Add(1)
+		go func() {
+			defer r.async.wg.Done()
+			for {
+				job, ok := r.async.take()
+				if !ok {
+					return
+				}
+				r.process(job)
+			}
+		}()
+	}
+}
+
+// take waits for the next job and counts it in flight. It returns false once
+// the queue is closed and empty or Shutdown has stopped the workers.
+func (p *asyncProcessor) take() (asyncJob, bool) {
+	p.handoff.RLock()
+	defer p.handoff.RUnlock()
+	select {
+	case <-p.stop:
+		return asyncJob{}, false
+	case job, ok := <-p.queue:
+		if !ok {
+			return asyncJob{}, false
+		}
+		// select picks randomly when stop is also closed.
+		select {
+		case <-p.stop:
+			p.unprocessedMu.Lock()
+			p.unprocessed = append(p.unprocessed, job.body)
+			p.unprocessedMu.Unlock()
+			return asyncJob{}, false
+		default:
+		}
+		p.inFlight.Add(1)
+		return job, true
+	}
+}
+
+// process handles a job counted in flight by take.
+func (r *WebhookReceiver) process(job asyncJob) {
+	defer r.async.inFlight.Add(-1)
+	err := r.dispatch(job.ctx, job.body)
+	if err != nil {
+		r.reportAsyncError(err)
+	}
+	if settled(err) {
+		r.complete(job.ctx, job.storeID)
+	}
+}
+
+func (r *WebhookReceiver) reportAsyncError(err error) {
+	if r.asyncErrorFunc != nil {
+		r.asyncErrorFunc(err)
+	}
+}
+
+// enqueue queues a verified delivery. reqCtx bounds how long OverflowBlock
+// waits; the job's own context outlives the request.
+func (r *WebhookReceiver) enqueue(reqCtx context.Context, job asyncJob) error {
+	p := r.async
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return ErrWebhookReceiverClosed
+	}
+
+	if r.overflowPolicy == OverflowBlock {
+		select {
+		case p.queue <- job:
+			return nil
+		case <-reqCtx.Done():
+			return reqCtx.Err()
+		}
+	}
+
+	select {
+	case p.queue <- job:
+		return nil
+	default:
+	}
+	if r.overflowPolicy == OverflowDrop {
+		r.logger.WarnContext(job.ctx, "webhook delivery dropped", "error", ErrWebhookQueueFull)
+		r.metrics.DeliveryRejected(RejectReasonQueueFull)
+		r.reportAsyncError(ErrWebhookQueueFull)
+		return nil
+	}
+	return ErrWebhookQueueFull
+}
+
+// ShutdownError is returned by Shutdown when ctx is done before the queue
+// has drained.
+type ShutdownError struct {
+	// Unprocessed holds the bodies of queued deliveries that were never
+	// handled. They were acknowledged to the sender, which will not retry
+	// them; with WithStore they also remain pending in the store.
+	Unprocessed [][]byte
+	// InFlight is the number of deliveries still being handled. Their
+	// handlers keep running in the background.
+	InFlight int
+	// Err is the error from ctx.
+	Err error
+}
+
+func (e *ShutdownError) Error() string {
+	return fmt.Sprintf("corestream: webhook receiver shutdown: %d queued deliveries unprocessed, %d in flight: %v",
+		len(e.Unprocessed), e.InFlight, e.Err)
+}
+
+func (e *ShutdownError) Unwrap() error { return e.Err }
+
+// Shutdown stops accepting deliveries, answering new ones with 503 so the
+// sender retries them elsewhere, and waits for queued and in-flight
+// deliveries to be processed. If ctx is done first, workers stop taking
+// deliveries from the queue and Shutdown returns a *ShutdownError listing
+// what was left unprocessed; it wraps ctx.Err(). Shutdown is a no-op for
+// receivers without WithAsyncProcessing.
+func (r *WebhookReceiver) Shutdown(ctx context.Context) error {
+	p := r.async
+	if p == nil {
+		return nil
+	}
+
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.queue)
+	}
+	p.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+	}
+
+	p.stopOnce.Do(func() { close(p.stop) })
+	// Wait for workers to finish handing off jobs they already took.
+	p.handoff.Lock()
+	shutdownErr := &ShutdownError{Err: ctx.Err(), InFlight: int(p.inFlight.Load())}
+	p.unprocessedMu.Lock()
+	shutdownErr.Unprocessed, p.unprocessed = p.unprocessed, nil
+	p.unprocessedMu.Unlock()
+	for job := range p.queue {
+		shutdownErr.Unprocessed = append(shutdownErr.Unprocessed, job.body)
+	}
+	p.handoff.Unlock()
+	r.logger.WarnContext(ctx, "webhook receiver shut down before draining",
+		"unprocessed", len(shutdownErr.Unprocessed), "in_flight", shutdownErr.InFlight)
+	return shutdownErr
+}
@@ -0,0 +1,229 @@
+package corestream
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func sendSignedWebhook(receiver *WebhookReceiver, body []byte, secret string) int {
+	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+	req.Header.Set(SignatureHeader, generateSignature(body, secret))
+	rec := httptest.NewRecorder()
+	receiver.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestWebhookReceiver_Async(t *testing.T) {
+	secret := "test-secret"
+	var handled atomic.Int32
+	receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+		handled.Add(1)
+		return nil
+	}, WithAsyncProcessing(2, 10))
+
+	for range 5 {
+		if code := sendSignedWebhook(receiver, []byte(`{"id":"notif_1"}`), secret); code != http.StatusOK {
+			t.Errorf("expected status 200, got %d", code)
+		}
+	}
+
+	if err := receiver.Shutdown(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := handled.Load(); got != 5 {
+		t.Errorf("expected 5 handled notifications, got %d", got)
+	}
+	if code := sendSignedWebhook(receiver, []byte(`{"id":"notif_2"}`), secret); code != http.StatusServiceUnavailable {
+		t.Errorf("expected status 503 after shutdown, got %d", code)
+	}
+}
+
+func TestWebhookReceiver_AsyncOverflow(t *testing.T) {
+	secret := "test-secret"
+	body := []byte(`{"id":"notif_1"}`)
+
+	newBlockedReceiver := func(opts ...WebhookReceiverOption) (*WebhookReceiver, chan struct{}) {
+		started := make(chan struct{}, 1)
+		release := make(chan struct{})
+		opts = append([]WebhookReceiverOption{WithAsyncProcessing(1, 1)}, opts...)
+		receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+			select {
+			case started <- struct{}{}:
+			default:
+			}
+			<-release
+			return nil
+		}, opts...)
+		// Occupy the worker, then fill the queue.
+		sendSignedWebhook(receiver, body, secret)
+		<-started
+		sendSignedWebhook(receiver, body, secret)
+		return receiver, release
+	}
+
+	t.Run("reject", func(t *testing.T) {
+		receiver, release := newBlockedReceiver()
+		if code := sendSignedWebhook(receiver, body, secret); code != http.StatusServiceUnavailable {
+			t.Errorf("expected status 503, got %d", code)
+		}
+		close(release)
+		receiver.Shutdown(context.Background())
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		var mu sync.Mutex
+		var errs []error
+		receiver, release := newBlockedReceiver(WithOverflowPolicy(OverflowDrop), WithAsyncErrorHandler(func(err error) {
+			mu.Lock()
+			errs = append(errs, err)
+			mu.Unlock()
+		}))
+		if code := sendSignedWebhook(receiver, body, secret); code != http.StatusOK {
+			t.Errorf("expected status 200, got %d", code)
+		}
+		close(release)
+		receiver.Shutdown(context.Background())
+
+		mu.Lock()
+		defer mu.Unlock()
+		if len(errs) != 1 || !errors.Is(errs[0], ErrWebhookQueueFull) {
+			t.Errorf("expected one ErrWebhookQueueFull, got %v", errs)
+		}
+	})
+
+	t.Run("block", func(t *testing.T) {
+		receiver, release := newBlockedReceiver(WithOverflowPolicy(OverflowBlock))
+		done := make(chan int)
+		go func() { done <- sendSignedWebhook(receiver, body, secret) }()
+
+		select {
+		case code := <-done:
+			t.Fatalf("expected request to block, got status %d", code)
+		case <-time.After(50 * time.Millisecond):
+		}
+		close(release)
+		if code := <-done; code != http.StatusOK {
+			t.Errorf("expected status 200, got %d", code)
+		}
+		receiver.Shutdown(context.Background())
+	})
+}
+
+func TestWebhookReceiver_ShutdownTimeout(t *testing.T) {
+	secret := "test-secret"
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	var handled atomic.Int32
+	receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-release
+		handled.Add(1)
+		return nil
+	}, WithAsyncProcessing(1, 5))
+
+	sendSignedWebhook(receiver, []byte(`{"id":"notif_1"}`), secret)
+	<-started
+	sendSignedWebhook(receiver, []byte(`{"id":"notif_2"}`), secret)
+	sendSignedWebhook(receiver, []byte(`{"id":"notif_3"}`), secret)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := receiver.Shutdown(ctx)
+
+	var shutdownErr *ShutdownError
+	if !errors.As(err, &shutdownErr) {
+		t.Fatalf("expected *ShutdownError, got %v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected error to wrap context.DeadlineExceeded, got %v", err)
+	}
+	if shutdownErr.InFlight != 1 {
+		t.Errorf("expected 1 in-flight delivery, got %d", shutdownErr.InFlight)
+	}
+	if len(shutdownErr.Unprocessed) != 2 || string(shutdownErr.Unprocessed[0]) != `{"id":"notif_2"}` {
+		t.Errorf("expected notif_2 and notif_3 unprocessed, got %q", shutdownErr.Unprocessed)
+	}
+
+	close(release)
+	if err := receiver.Shutdown(context.Background()); err != nil {
+		t.Errorf("expected second Shutdown to succeed once drained, got %v", err)
+	}
+	if got := handled.Load(); got != 1 {
+		t.Errorf("expected only the in-flight delivery to be handled, got %d", got)
+	}
+}
+
+func TestWebhookReceiver_ShutdownStopsTakingDeliveries(t *testing.T) {
+	secret := "test-secret"
+	const total = 20
+	var receiver *WebhookReceiver
+	started := make(chan struct{})
+	var handled []string
+	receiver = NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+		handled = append(handled, n.ID)
+		if n.ID == "notif_0" {
+			close(started)
+			// Finish only once Shutdown has stopped the workers, while the
+			// queue still holds deliveries.
+			<-receiver.async.stop
+		}
+		return nil
+	}, WithAsyncProcessing(1, total))
+
+	for i := range total {
+		body := []byte(fmt.Sprintf(`{"id":"notif_%d"}`, i))
+		if code := sendSignedWebhook(receiver, body, secret); code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", code)
+		}
+	}
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := receiver.Shutdown(ctx)
+	var shutdownErr *ShutdownError
+	if !errors.As(err, &shutdownErr) {
+		t.Fatalf("expected *ShutdownError, got %v", err)
+	}
+	if err := receiver.Shutdown(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(handled) != 1 {
+		t.Errorf("expected only notif_0 to be handled, got %v", handled)
+	}
+	if got := len(shutdownErr.Unprocessed); got != total-1 {
+		t.Errorf("expected %d unprocessed deliveries, got %d", total-1, got)
+	}
+}
+
+func TestWebhookReceiver_AsyncHandlerError(t *testing.T) {
+	secret := "test-secret"
+	handlerErr := errors.New("downstream unavailable")
+	var got error
+	receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+		return handlerErr
+	}, WithAsyncProcessing(1, 1), WithAsyncErrorHandler(func(err error) { got = err }))
+
+	if code := sendSignedWebhook(receiver, []byte(`{"id":"notif_1"}`), secret); code != http.StatusOK {
+		t.Errorf("expected status 200, got %d", code)
+	}
+	receiver.Shutdown(context.Background())
+	if !errors.Is(got, handlerErr) {
+		t.Errorf("expected handler error, got %v", got)
+	}
+	if code := sendSignedWebhook(receiver, []byte(`not json`), secret); code != http.StatusBadRequest {
+		t.Errorf("expected status 400, got %d", code)
+	}
+}
@@ -0,0 +1,129 @@
+package corestream
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DedupStore records which deliveries a WebhookReceiver has already handled.
+// Implementations backed by a shared store (e.g. Redis SET NX with an expiry)
+// deduplicate across several receiver instances.
+type DedupStore interface {
+	// Reserve records id for ttl and reports whether it was newly recorded.
+	// It returns false if id is already recorded.
+	Reserve(ctx context.Context, id string, ttl time.Duration) (bool, error)
+	// Release removes id, so that a retry of a delivery whose handler failed
+	// is processed again.
+	Release(ctx context.Context, id string) error
+}
+
+// WithDeduplication suppresses deliveries whose ID was already handled within
+// window, since the sender retries deliveries it believes failed. Notifications,
+// digests, and events are keyed by their ID; notifications in a batch are
+// deduplicated individually. If store is nil, an in-memory store is used.
+// Errors from the store fail the delivery so that it is retried.
+func WithDeduplication(window time.Duration, store DedupStore) WebhookReceiverOption {
+	return func(r *WebhookReceiver) {
+		if store == nil {
+			store = NewMemoryDedupStore()
+		}
+		r.dedupStore = store
+		r.dedupWindow = window
+	}
+}
+
+// once runs fn unless id was already handled. If fn fails, id is released.
+func (r *WebhookReceiver) once(ctx context.Context, id string, fn func() error) error {
+	if r.dedupStore == nil || id == "" {
+		return fn()
+	}
+	ok, err := r.dedupStore.Reserve(ctx, id, r.dedupWindow)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+	if err := fn(); err != nil {
+		r.dedupStore.Release(context.WithoutCancel(ctx), id)
+		return err
+	}
+	return nil
+}
+
+// unseen reserves the IDs of notifications and returns those not already
+// handled, along with a function that releases the new reservations.
+func (r *WebhookReceiver) unseen(ctx context.Context, notifications []WebhookNotification) ([]WebhookNotification, func(), error) {
+	if r.dedupStore == nil {
+		return notifications, func() {}, nil
+	}
+	var fresh []WebhookNotification
+	var reserved []string
+	release := func() {
+		for _, id := range reserved {
+			r.dedupStore.Release(context.WithoutCancel(ctx), id)
+		}
+	}
+	for _, n := range notifications {
+		if n.ID == "" {
+			fresh = append(fresh, n)
+			continue
+		}
+		ok, err := r.dedupStore.Reserve(ctx, n.ID, r.dedupWindow)
+		if err != nil {
+			release()
+			return nil, nil, err
+		}
+		if ok {
+			fresh = append(fresh, n)
+			reserved = append(reserved, n.ID)
+		}
+	}
+	return fresh, release, nil
+}
+
+// dedupSweepInterval is how often MemoryDedupStore removes expired IDs.
+const dedupSweepInterval = time.Minute
+
+// MemoryDedupStore is an in-memory DedupStore for a single receiver process.
+type MemoryDedupStore struct {
+	mu        sync.Mutex
+	expires   map[string]time.Time
+	lastSweep time.Time
+}
+
+// NewMemoryDedupStore creates an empty MemoryDedupStore.
+func NewMemoryDedupStore() *MemoryDedupStore {
+	return &MemoryDedupStore{expires: make(map[string]time.Time), lastSweep: time.Now()}
+}
+
+// Reserve implements DedupStore. Expired IDs are removed at most once per
+// dedupSweepInterval rather than on every call.
+func (s *MemoryDedupStore) Reserve(_ context.Context, id string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if exp, ok := s.expires[id]; ok && now.Before(exp) {
+		return false, nil
+	}
+	if now.Sub(s.lastSweep) >= dedupSweepInterval {
+		for k, exp := range s.expires {
+			if !now.Before(exp) {
+				delete(s.expires, k)
+			}
+		}
+		s.lastSweep = now
+	}
+	s.expires[id] = now.Add(ttl)
+	return true, nil
+}
+
+// Release implements DedupStore.
+func (s *MemoryDedupStore) Release(_ context.Context, id string) error {
+	s.mu.Lock()
+	delete(s.expires, id)
+	s.mu.Unlock()
+	return nil
+}
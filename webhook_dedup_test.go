@@ -0,0 +1,103 @@
+package corestream
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWebhookReceiver_Deduplication(t *testing.T) {
+	secret := "test-secret"
+	body := []byte(`{"id":"notif_1"}`)
+
+	calls := 0
+	fail := true
+	receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+		calls++
+		if fail {
+			return errors.New("transient")
+		}
+		return nil
+	}, WithDeduplication(time.Hour, nil))
+
+	if code := sendSignedWebhook(receiver, body, secret); code != http.StatusInternalServerError {
+		t.Errorf("expected status 500, got %d", code)
+	}
+	fail = false
+	for range 3 {
+		if code := sendSignedWebhook(receiver, body, secret); code != http.StatusOK {
+			t.Errorf("expected status 200, got %d", code)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("expected handler to run for the failed attempt and the first retry, ran %d times", calls)
+	}
+}
+
+func TestWebhookReceiver_DeduplicationBatch(t *testing.T) {
+	secret := "test-secret"
+	var got [][]string
+	receiver := NewWebhookReceiver(secret, nil,
+		WithDeduplication(time.Hour, nil),
+		WithBatchHandler(func(notifications []WebhookNotification) error {
+			var ids []string
+			for _, n := range notifications {
+				ids = append(ids, n.ID)
+			}
+			got = append(got, ids)
+			return nil
+		}))
+
+	sendSignedWebhook(receiver, []byte(`[{"id":"a"},{"id":"b"}]`), secret)
+	sendSignedWebhook(receiver, []byte(`[{"id":"b"},{"id":"c"}]`), secret)
+	sendSignedWebhook(receiver, []byte(`[{"id":"a"},{"id":"c"}]`), secret)
+
+	if len(got) != 2 || len(got[1]) != 1 || got[1][0] != "c" {
+		t.Errorf("unexpected batches %v", got)
+	}
+}
+
+func TestMemoryDedupStore(t *testing.T) {
+	store := NewMemoryDedupStore()
+	ctx := context.Background()
+
+	if ok, _ := store.Reserve(ctx, "a", time.Hour); !ok {
+		t.Error("expected first reservation to succeed")
+	}
+	if ok, _ := store.Reserve(ctx, "a", time.Hour); ok {
+		t.Error("expected duplicate reservation to fail")
+	}
+	store.Release(ctx, "a")
+	if ok, _ := store.Reserve(ctx, "a", time.Hour); !ok {
+		t.Error("expected reservation after release to succeed")
+	}
+
+	if ok, _ := store.Reserve(ctx, "b", -time.Second); !ok {
+		t.Error("expected reservation to succeed")
+	}
+	if ok, _ := store.Reserve(ctx, "b", time.Hour); !ok {
+		t.Error("expected reservation of expired id to succeed")
+	}
+}
+
+func TestMemoryDedupStore_Sweep(t *testing.T) {
+	store := NewMemoryDedupStore()
+	ctx := context.Background()
+
+	store.Reserve(ctx, "expired", -time.Second)
+	store.Reserve(ctx, "live", time.Hour)
+	if len(store.expires) != 2 {
+		t.Fatalf("expected no sweep within the interval, got %d entries", len(store.expires))
+	}
+
+	store.lastSweep = time.Now().Add(-dedupSweepInterval)
+	store.Reserve(ctx, "new", time.Hour)
+	if _, ok := store.expires["expired"]; ok {
+		t.Error("expected expired id to be swept")
+	}
+	if len(store.expires) != 2 {
+		t.Errorf("expected live and new ids to remain, got %d entries", len(store.expires))
+	}
+}
@@ -0,0 +1,35 @@
+package corestream
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestWebhookReceiver_HandleDelivery(t *testing.T) {
+	secret := "test-secret"
+	body := []byte(`{"id":"notif_1"}`)
+
+	var got *WebhookNotification
+	receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+		got = n
+		return nil
+	})
+
+	header := http.Header{}
+	header.Set(SignatureHeader, generateSignature(body, secret))
+	resp := receiver.HandleDelivery(context.Background(), Delivery{Header: header, RemoteAddr: "203.0.113.7", Body: body})
+
+	if resp.StatusCode != http.StatusOK || string(resp.Body) != `{"status":"ok"}` {
+		t.Errorf("unexpected response %d %s", resp.StatusCode, resp.Body)
+	}
+	if got == nil || got.ID != "notif_1" {
+		t.Errorf("unexpected notification %+v", got)
+	}
+
+	header.Set(SignatureHeader, generateSignature(body, "wrong-secret"))
+	resp = receiver.HandleDelivery(context.Background(), Delivery{Header: header, Body: body})
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected status 401, got %d", resp.StatusCode)
+	}
+}
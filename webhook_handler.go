@@ -1,18 +1,34 @@
 package corestream
 
 import (
+	"bytes"
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 const (
 	// SignatureHeader is the HTTP header containing the HMAC signature.
 	SignatureHeader = "X-Webhook-Signature"
 
+	// SignatureKeyHeader is the HTTP header identifying the key that produced
+	// the signature.
+	SignatureKeyHeader = "X-Webhook-Signature-Key"
+
+	// TimestampHeader is the HTTP header containing the Unix time, in seconds,
+	// at which the delivery was signed. When present, the signature covers
+	// the timestamp followed by "." and the body.
+	TimestampHeader = "X-Webhook-Timestamp"
+
 	// MaxWebhookBodySize limits the webhook body to prevent DoS (1 MB).
 	MaxWebhookBodySize = 1 << 20
 )
@@ -20,6 +36,44 @@ const (
 // WebhookHandler is a function that processes validated webhook notifications.
 type WebhookHandler func(notification *WebhookNotification) error
 
+// WebhookContextHandler is a WebhookHandler that also receives the request
+// context, which is canceled when the client disconnects or the server shuts
+// down and carries the DeliveryInfo for the request.
+type WebhookContextHandler func(ctx context.Context, notification *WebhookNotification) error
+
+// DeliveryInfo describes the HTTP request that carried a webhook delivery.
+// Body is the exact payload that was signed, for persisting and
+// re-verifying later; it must not be modified.
+type DeliveryInfo struct {
+	Header     http.Header
+	RemoteAddr string
+	ReceivedAt time.Time
+	Body       []byte
+}
+
+type deliveryInfoKey struct{}
+
+// DeliveryInfoFromContext returns the DeliveryInfo stored in a context passed
+// to a WebhookContextHandler, or nil if there is none.
+func DeliveryInfoFromContext(ctx context.Context) *DeliveryInfo {
+	info, _ := ctx.Value(deliveryInfoKey{}).(*DeliveryInfo)
+	return info
+}
+
+// WebhookDigestHandler is a function that processes validated digest payloads.
+type WebhookDigestHandler func(digest *WebhookNotificationDigest) error
+
+// WebhookBatchHandler is a function that processes validated batch payloads.
+type WebhookBatchHandler func(notifications []WebhookNotification) error
+
+// WebhookEventHandler is a function that processes validated non-notification
+// events.
+type WebhookEventHandler func(event *WebhookEvent) error
+
+// WebhookEventContextHandler processes a delivery routed by event type with
+// WebhookReceiver.On.
+type WebhookEventContextHandler func(ctx context.Context, event *WebhookEvent) error
+
 // WebhookReceiverOption configures the WebhookReceiver.
 type WebhookReceiverOption func(*WebhookReceiver)
 
@@ -31,70 +85,669 @@ func WithoutSignatureVerification() WebhookReceiverOption {
 	}
 }
 
+// WithDigestHandler sets the handler for digest payloads sent by alerts with
+// a digest configuration. Without it, each notification in a digest is passed
+// to the regular handler in order.
+func WithDigestHandler(handler WebhookDigestHandler) WebhookReceiverOption {
+	return func(r *WebhookReceiver) {
+		r.digestHandler = handler
+	}
+}
+
+// WithBatchHandler sets the handler for batch payloads sent by webhooks with
+// a batch configuration. Without it, each notification in a batch is passed
+// to the regular handler in order.
+func WithBatchHandler(handler WebhookBatchHandler) WebhookReceiverOption {
+	return func(r *WebhookReceiver) {
+		r.batchHandler = handler
+	}
+}
+
+// WithEventHandler sets the handler for events other than notifications,
+// such as EventAlertUpdated. Without it, such events are acknowledged and
+// dropped.
+func WithEventHandler(handler WebhookEventHandler) WebhookReceiverOption {
+	return func(r *WebhookReceiver) {
+		r.eventHandler = handler
+	}
+}
+
+// WithSecrets sets a keyring of HMAC secrets by key ID. Requests whose
+// SignatureKeyHeader names a key in the keyring are verified with that
+// secret, so secrets can be rotated by adding the new key before the sender
+// switches to it. Requests without a key ID, or naming a key found neither in
+// the keyring nor among the WithSigningKeys keys, are verified with the
+// default and previous secrets; if those fail too, a request naming an
+// unknown key is rejected with ErrUnknownSigningKey. Keys with an empty
+// secret are ignored.
+func WithSecrets(secrets map[string]string) WebhookReceiverOption {
+	return func(r *WebhookReceiver) {
+		r.secrets = make(map[string][]byte, len(secrets))
+		for id, secret := range secrets {
+			if secret != "" {
+				r.secrets[id] = []byte(secret)
+			}
+		}
+	}
+}
+
+// WithReceiverLogger logs rejected signatures, invalid payloads, and handler
+// errors to logger, with the notification and alert IDs where known.
+func WithReceiverLogger(logger *slog.Logger) WebhookReceiverOption {
+	return func(r *WebhookReceiver) {
+		if logger != nil {
+			r.logger = logger
+		}
+	}
+}
+
+// WithNotificationValidation rejects notifications missing their ID, AlertID,
+// or Timestamp with 400 Bad Request instead of passing them to the handlers.
+// A batch or digest is rejected as a whole if any notification in it is
+// invalid. The rejection error wraps ErrInvalidNotification; in asynchronous
+// mode it is reported to the async error handler.
+func WithNotificationValidation() WebhookReceiverOption {
+	return func(r *WebhookReceiver) {
+		r.validateNotifications = true
+	}
+}
+
+// WithPreviousSecret also accepts HMAC signatures made with secret until
+// the given time, so deliveries signed before a RotateWebhookSecret call keep
+// verifying during the rotation window. A zero until never expires. An empty
+// secret means there is no previous secret.
+func WithPreviousSecret(secret string, until time.Time) WebhookReceiverOption {
+	return func(r *WebhookReceiver) {
+		r.previousSecret = nil
+		if secret != "" {
+			r.previousSecret = []byte(secret)
+		}
+		r.previousSecretUntil = until
+	}
+}
+
+// WithTimestampTolerance requires every request to carry a TimestampHeader
+// within d of the current time, rejecting replays of captured deliveries.
+func WithTimestampTolerance(d time.Duration) WebhookReceiverOption {
+	return func(r *WebhookReceiver) {
+		r.timestampTolerance = d
+	}
+}
+
+// SigningKeySource returns the public keys used to verify asymmetric webhook
+// signatures. Client.FetchSigningKeys can be used directly as a source. When
+// a delivery names a key the source did not return, the receiver calls the
+// source again, at most once a minute, in case the keys were rotated.
+type SigningKeySource func(ctx context.Context) ([]SigningKey, error)
+
+// WithSigningKeys enables Ed25519 and ECDSA signature verification.
+// Requests with a SignatureKeyHeader are verified against the matching public
+// key from source, and rejected with ErrExpiredSigningKey if the key's
+// ExpiresAt has passed. Other requests, and requests naming a key that source
+// does not have, are verified with the HMAC secrets as described for
+// WithSecrets.
+func WithSigningKeys(source SigningKeySource) WebhookReceiverOption {
+	return func(r *WebhookReceiver) {
+		r.signingKeys = source
+	}
+}
+
 // WebhookReceiver handles incoming webhooks with signature verification.
 // It implements http.Handler for easy integration with HTTP servers.
 type WebhookReceiver struct {
 	secret           []byte
-	handler          WebhookHandler
+	handler          WebhookContextHandler
 	maxBodySize      int64
 	skipVerification bool
+	signingKeys      SigningKeySource
+	keyRefresh       refreshLimiter
+	digestHandler    WebhookDigestHandler
+	eventHandler     WebhookEventHandler
+	batchHandler     WebhookBatchHandler
+	secrets          map[string][]byte
+
+	previousSecret      []byte
+	previousSecretUntil time.Time
+	timestampTolerance  time.Duration
+
+	async          *asyncProcessor
+	overflowPolicy OverflowPolicy
+	asyncErrorFunc func(err error)
+
+	dedupStore  DedupStore
+	dedupWindow time.Duration
+
+	store NotificationStore
+
+	handlerRetries int
+	handlerBackoff time.Duration
+
+	rateLimiter *rateLimiter
+
+	validateNotifications bool
+
+	successStatus    int
+	successBody      []byte
+	acceptedResponse bool
+	errorStatus      func(err error) int
+
+	logger  *slog.Logger
+	metrics ReceiverMetrics
+
+	routes    map[EventType]WebhookEventContextHandler
+	onUnknown WebhookEventContextHandler
 }
 
 // NewWebhookReceiver creates a new webhook receiver.
-// The secret is used for HMAC-SHA256 signature verification.
+// The secret is used for HMAC-SHA256 signature verification. It may be empty
+// when every delivery carries a key ID from WithSecrets or WithSigningKeys.
 // The handler is called for each validated webhook notification.
 func NewWebhookReceiver(secret string, handler WebhookHandler, opts ...WebhookReceiverOption) *WebhookReceiver {
+	if handler == nil {
+		return NewWebhookReceiverContext(secret, nil, opts...)
+	}
+	return NewWebhookReceiverContext(secret, func(_ context.Context, n *WebhookNotification) error {
+		return handler(n)
+	}, opts...)
+}
+
+// NewWebhookReceiverContext is like NewWebhookReceiver but takes a handler
+// that receives the request context.
+func NewWebhookReceiverContext(secret string, handler WebhookContextHandler, opts ...WebhookReceiverOption) *WebhookReceiver {
 	r := &WebhookReceiver{
 		secret:      []byte(secret),
 		handler:     handler,
 		maxBodySize: int64(MaxWebhookBodySize),
+		logger:      slog.New(slog.DiscardHandler),
+		metrics:     noopMetrics{},
 	}
 	for _, opt := range opts {
 		opt(r)
 	}
+	if r.async != nil {
+		r.startWorkers()
+	}
 	return r
 }
 
+// On routes deliveries of the given event type to handler, taking precedence
+// over the notification, digest, and event handlers. Notifications without an
+// event field are EventNotificationCreated and digests are
+// EventNotificationDigest; use WebhookEvent.Notification or
+// WebhookEvent.Digest to decode them. On must not be called while the
+// receiver is serving requests.
+func (r *WebhookReceiver) On(event EventType, handler WebhookEventContextHandler) {
+	if r.routes == nil {
+		r.routes = make(map[EventType]WebhookEventContextHandler)
+	}
+	r.routes[event] = handler
+}
+
+// OnUnknown sets the handler for deliveries that no other handler accepts.
+// Without it, such deliveries are acknowledged and dropped. OnUnknown must not
+// be called while the receiver is serving requests.
+func (r *WebhookReceiver) OnUnknown(handler WebhookEventContextHandler) {
+	r.onUnknown = handler
+}
+
 // ServeHTTP implements http.Handler.
 func (r *WebhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.metrics.DeliveryReceived()
 	if req.Method != http.MethodPost {
+		r.metrics.DeliveryRejected(RejectReasonMethod)
 		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
 		return
 	}
 
 	body, err := io.ReadAll(io.LimitReader(req.Body, r.maxBodySize))
 	if err != nil {
+		r.logger.WarnContext(req.Context(), "failed to read webhook body", "remote_addr", req.RemoteAddr, "error", err)
+		r.metrics.DeliveryRejected(RejectReasonBody)
 		http.Error(w, "failed to read body", http.StatusBadRequest)
 		return
 	}
 	defer req.Body.Close()
 
+	resp := r.handleDelivery(req.Context(), Delivery{Header: req.Header, RemoteAddr: req.RemoteAddr, Body: body})
+	resp.write(w)
+}
+
+// Delivery is a webhook request whose body has already been read, for use
+// with HandleDelivery.
+type Delivery struct {
+	Header     http.Header
+	RemoteAddr string
+	Body       []byte
+}
+
+// DeliveryResponse is the response to send for a Delivery. Error responses
+// have a plain-text Body.
+type DeliveryResponse struct {
+	StatusCode int
+	Body       []byte
+}
+
+func errorResponse(status int, msg string) DeliveryResponse {
+	return DeliveryResponse{StatusCode: status, Body: []byte(msg)}
+}
+
+func (resp DeliveryResponse) write(w http.ResponseWriter) {
+	if resp.StatusCode >= 400 {
+		http.Error(w, string(resp.Body), resp.StatusCode)
+		return
+	}
+	w.WriteHeader(resp.StatusCode)
+	w.Write(resp.Body)
+}
+
+// HandleDelivery verifies and processes a delivery read by a framework that
+// does not use net/http request bodies, such as Fiber, or whose middleware has
+// already consumed the body. The body must be exactly as received; the
+// receiver's body size limit is not applied. See the README for examples.
+func (r *WebhookReceiver) HandleDelivery(ctx context.Context, d Delivery) DeliveryResponse {
+	r.metrics.DeliveryReceived()
+	return r.handleDelivery(ctx, d)
+}
+
+func (r *WebhookReceiver) handleDelivery(ctx context.Context, d Delivery) DeliveryResponse {
 	if !r.skipVerification {
-		signature := req.Header.Get(SignatureHeader)
-		if signature == "" {
-			http.Error(w, ErrMissingSignature.Error(), http.StatusUnauthorized)
-			return
+		if err := r.verify(ctx, d.Header, d.Body); err != nil {
+			r.metrics.DeliveryRejected(RejectReasonSignature)
+			var sigErr *signatureError
+			if errors.As(err, &sigErr) {
+				r.logger.WarnContext(ctx, "webhook signature rejected",
+					"remote_addr", d.RemoteAddr, "key_id", d.Header.Get(SignatureKeyHeader), "error", err)
+				return errorResponse(http.StatusUnauthorized, err.Error())
+			}
+			r.logger.ErrorContext(ctx, "failed to verify webhook signature", "error", err)
+			return errorResponse(http.StatusInternalServerError, "failed to verify signature")
 		}
+	}
+	r.metrics.DeliveryVerified()
 
-		if !verifySignature(body, signature, r.secret) {
-			http.Error(w, ErrInvalidSignature.Error(), http.StatusUnauthorized)
-			return
+	if r.rateLimiter != nil && !r.rateLimiter.allow() {
+		r.logger.WarnContext(ctx, "webhook delivery rate limited", "remote_addr", d.RemoteAddr)
+		r.metrics.DeliveryRejected(RejectReasonRateLimit)
+		return errorResponse(http.StatusTooManyRequests, "rate limit exceeded")
+	}
+
+	reqCtx := ctx
+	ctx = context.WithValue(ctx, deliveryInfoKey{}, &DeliveryInfo{
+		Header:     d.Header.Clone(),
+		RemoteAddr: d.RemoteAddr,
+		ReceivedAt: time.Now(),
+		Body:       d.Body,
+	})
+
+	if r.async != nil {
+		if !json.Valid(d.Body) {
+			r.logger.WarnContext(ctx, "invalid webhook payload", "remote_addr", d.RemoteAddr)
+			r.metrics.DeliveryRejected(RejectReasonPayload)
+			return errorResponse(http.StatusBadRequest, "invalid payload")
+		}
+		id, err := r.save(ctx, d.Body)
+		if err != nil {
+			return errorResponse(http.StatusServiceUnavailable, "webhook store unavailable")
 		}
+		if err := r.enqueue(reqCtx, asyncJob{ctx: context.WithoutCancel(ctx), body: d.Body, storeID: id}); err != nil {
+			r.logger.WarnContext(ctx, "webhook delivery not queued", "error", err)
+			r.metrics.DeliveryRejected(RejectReasonQueueFull)
+			return errorResponse(http.StatusServiceUnavailable, "webhook queue unavailable")
+		}
+		return r.queuedResponse()
 	}
 
-	notification, err := ParseWebhookNotification(body)
+	id, err := r.save(ctx, d.Body)
 	if err != nil {
-		http.Error(w, "invalid payload", http.StatusBadRequest)
-		return
+		return errorResponse(http.StatusServiceUnavailable, "webhook store unavailable")
 	}
+	if err := r.dispatch(ctx, d.Body); err != nil {
+		var payloadErr *payloadError
+		if errors.As(err, &payloadErr) {
+			r.complete(ctx, id)
+			return errorResponse(http.StatusBadRequest, "invalid payload")
+		}
+		return errorResponse(r.handlerErrorStatus(err), "handler error")
+	}
+	r.complete(ctx, id)
 
-	if err := r.handler(notification); err != nil {
-		http.Error(w, "handler error", http.StatusInternalServerError)
-		return
+	return r.successResponse(http.StatusOK, []byte(`{"status":"ok"}`))
+}
+
+// payloadError marks webhook bodies that could not be parsed.
+type payloadError struct {
+	err error
+}
+
+func (e *payloadError) Error() string { return e.err.Error() }
+func (e *payloadError) Unwrap() error { return e.err }
+
+// dispatch parses a verified webhook body and passes it to the handlers.
+func (r *WebhookReceiver) dispatch(ctx context.Context, body []byte) (err error) {
+	defer func() {
+		var payloadErr *payloadError
+		if errors.As(err, &payloadErr) {
+			r.logger.WarnContext(ctx, "invalid webhook payload", "error", err)
+			r.metrics.DeliveryRejected(RejectReasonPayload)
+		}
+	}()
+
+	if isJSONArray(body) {
+		notifications, err := ParseWebhookNotificationBatch(body)
+		if err != nil {
+			return &payloadError{err}
+		}
+		if err := r.validate(notifications...); err != nil {
+			return err
+		}
+		if r.batchHandler == nil && r.handler == nil {
+			return r.routeEach(ctx, body)
+		}
+		if r.batchHandler == nil {
+			return r.handleEach(ctx, notifications)
+		}
+		fresh, release, err := r.unseen(ctx, notifications)
+		if err != nil || len(fresh) == 0 {
+			return err
+		}
+		if err := r.retried(ctx, HandlerKindBatch, r.timed(HandlerKindBatch, func() error { return r.batchHandler(fresh) }))(); err != nil {
+			release()
+			r.logger.ErrorContext(ctx, "webhook batch handler failed", "batch_size", len(fresh), "error", err)
+			return err
+		}
+		return nil
+	}
+
+	var envelope struct {
+		Type  string    `json:"type"`
+		Event EventType `json:"event"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return &payloadError{err}
+	}
+	eventType := envelope.Event
+	switch {
+	case envelope.Type == WebhookPayloadTypeDigest:
+		eventType = EventNotificationDigest
+	case eventType == "":
+		eventType = EventNotificationCreated
+	}
+
+	if handler, ok := r.routes[eventType]; ok {
+		return r.route(ctx, handler, body)
+	}
+
+	switch {
+	case eventType == EventNotificationDigest && (r.digestHandler != nil || r.handler != nil):
+		digest, err := ParseWebhookNotificationDigest(body)
+		if err != nil {
+			return &payloadError{err}
+		}
+		if err := r.validate(digest.Notifications...); err != nil {
+			return err
+		}
+		if r.digestHandler != nil {
+			err = r.once(ctx, digest.ID, r.retried(ctx, HandlerKindDigest, r.timed(HandlerKindDigest, func() error { return r.digestHandler(digest) })))
+			if err != nil {
+				r.logger.ErrorContext(ctx, "webhook digest handler failed", "digest_id", digest.ID, "alert_id", digest.AlertID, "error", err)
+			}
+			return err
+		}
+		return r.handleEach(ctx, digest.Notifications)
+
+	case eventType == EventNotificationCreated && r.handler != nil:
+		notification, err := ParseWebhookNotification(body)
+		if err != nil {
+			return &payloadError{err}
+		}
+		if err := r.validate(*notification); err != nil {
+			return err
+		}
+		return r.handleNotification(ctx, notification)
+
+	case eventType != EventNotificationCreated && eventType != EventNotificationDigest && r.eventHandler != nil:
+		return r.route(ctx, func(_ context.Context, event *WebhookEvent) error { return r.eventHandler(event) }, body)
+
+	case r.onUnknown != nil:
+		return r.route(ctx, r.onUnknown, body)
+	}
+	return nil
+}
+
+// route passes a delivery to a handler registered with On or OnUnknown.
+func (r *WebhookReceiver) route(ctx context.Context, handler WebhookEventContextHandler, body []byte) error {
+	event, err := ParseWebhookEvent(body)
+	if err != nil {
+		return &payloadError{err}
+	}
+	err = r.once(ctx, event.ID, r.retried(ctx, HandlerKindEvent, r.timed(HandlerKindEvent, func() error { return handler(ctx, event) })))
+	if err != nil {
+		r.logger.ErrorContext(ctx, "webhook event handler failed", "event_id", event.ID, "event", event.Event, "error", err)
+	}
+	return err
+}
+
+// routeEach passes each notification in a batch to the handler registered
+// for EventNotificationCreated, or to OnUnknown, stopping at the first error.
+// Batches are acknowledged and dropped if neither is registered.
+func (r *WebhookReceiver) routeEach(ctx context.Context, body []byte) error {
+	handler, ok := r.routes[EventNotificationCreated]
+	if !ok {
+		handler = r.onUnknown
+	}
+	if handler == nil {
+		return nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(body, &items); err != nil {
+		return &payloadError{err}
+	}
+	for _, item := range items {
+		if err := r.route(ctx, handler, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *WebhookReceiver) handleNotification(ctx context.Context, n *WebhookNotification) error {
+	err := r.once(ctx, n.ID, r.retried(ctx, HandlerKindNotification, r.timed(HandlerKindNotification, func() error { return r.handler(ctx, n) })))
+	if err != nil {
+		r.logger.ErrorContext(ctx, "webhook handler failed", "notification_id", n.ID, "alert_id", n.AlertID, "error", err)
+	}
+	return err
+}
+
+// validate checks notifications when WithNotificationValidation is set.
+func (r *WebhookReceiver) validate(notifications ...WebhookNotification) error {
+	if !r.validateNotifications {
+		return nil
+	}
+	for i := range notifications {
+		if err := notifications[i].Validate(); err != nil {
+			return &payloadError{err}
+		}
+	}
+	return nil
+}
+
+// handleEach passes notifications to the regular handler in order, stopping
+// at the first error.
+func (r *WebhookReceiver) handleEach(ctx context.Context, notifications []WebhookNotification) error {
+	for i := range notifications {
+		if err := r.handleNotification(ctx, &notifications[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isJSONArray(body []byte) bool {
+	trimmed := bytes.TrimLeft(body, " \t\r\n")
+	return len(trimmed) > 0 && trimmed[0] == '['
+}
+
+// signatureError marks verification failures caused by the request itself,
+// as opposed to failures loading keys.
+type signatureError struct {
+	err error
+}
+
+func (e *signatureError) Error() string { return e.err.Error() }
+func (e *signatureError) Unwrap() error { return e.err }
+
+// verify checks the request signature against the configured secret or
+// signing keys.
+func (r *WebhookReceiver) verify(ctx context.Context, header http.Header, body []byte) error {
+	signature := header.Get(SignatureHeader)
+	if signature == "" {
+		return &signatureError{ErrMissingSignature}
+	}
+
+	timestamp := header.Get(TimestampHeader)
+	if r.timestampTolerance > 0 {
+		if err := checkTimestamp(timestamp, r.timestampTolerance); err != nil {
+			return &signatureError{err}
+		}
+	}
+	body = signedPayload(timestamp, body)
+
+	if keyID := header.Get(SignatureKeyHeader); keyID != "" {
+		if secret := r.secrets[keyID]; len(secret) > 0 {
+			if !verifySignature(body, signature, secret) {
+				return &signatureError{ErrInvalidSignature}
+			}
+			return nil
+		}
+		if r.signingKeys != nil {
+			err := r.verifyWithSigningKey(ctx, keyID, body, signature)
+			if !errors.Is(err, ErrUnknownSigningKey) {
+				return err
+			}
+		}
+		if r.verifyDefaultSecrets(body, signature) {
+			return nil
+		}
+		if r.secrets != nil || r.signingKeys != nil {
+			return &signatureError{ErrUnknownSigningKey}
+		}
+		return &signatureError{ErrInvalidSignature}
+	}
+
+	if r.verifyDefaultSecrets(body, signature) {
+		return nil
+	}
+	return &signatureError{ErrInvalidSignature}
+}
+
+// verifyDefaultSecrets checks an HMAC signature against the default secret
+// and, within its rotation window, the previous secret.
+func (r *WebhookReceiver) verifyDefaultSecrets(body []byte, signature string) bool {
+	if len(r.secret) > 0 && verifySignature(body, signature, r.secret) {
+		return true
 	}
+	return len(r.previousSecret) > 0 && (r.previousSecretUntil.IsZero() || time.Now().Before(r.previousSecretUntil)) &&
+		verifySignature(body, signature, r.previousSecret)
+}
 
-	w.WriteHeader(http.StatusOK)
-	w.Write([]byte(`{"status":"ok"}`))
+func (r *WebhookReceiver) verifyWithSigningKey(ctx context.Context, keyID string, body []byte, signature string) error {
+	key, err := r.signingKey(ctx, keyID)
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		return &signatureError{ErrUnknownSigningKey}
+	}
+	if !key.ExpiresAt.IsZero() && !time.Now().Before(key.ExpiresAt) {
+		return &signatureError{ErrExpiredSigningKey}
+	}
+	ok, err := VerifyWebhookSignatureWithKey(body, signature, *key)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &signatureError{ErrInvalidSignature}
+	}
+	return nil
+}
+
+// signingKey looks up a signing key by ID. If the source does not have it,
+// the source is asked once more to refresh, at most once per
+// signingKeyRefreshInterval, in case the keys were rotated.
+func (r *WebhookReceiver) signingKey(ctx context.Context, keyID string) (*SigningKey, error) {
+	keys, err := r.signingKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if key := findSigningKey(keys, keyID); key != nil || !r.keyRefresh.allow(signingKeyRefreshInterval) {
+		return key, nil
+	}
+	keys, err = r.signingKeys(withSigningKeyRefresh(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return findSigningKey(keys, keyID), nil
+}
+
+func checkTimestamp(timestamp string, tolerance time.Duration) error {
+	if timestamp == "" {
+		return ErrMissingTimestamp
+	}
+	secs, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+	if age := time.Since(time.Unix(secs, 0)); age > tolerance || age < -tolerance {
+		return ErrInvalidTimestamp
+	}
+	return nil
+}
+
+// signedPayload returns the bytes covered by the signature.
+func signedPayload(timestamp string, body []byte) []byte {
+	if timestamp == "" {
+		return body
+	}
+	signed := make([]byte, 0, len(timestamp)+1+len(body))
+	signed = append(signed, timestamp...)
+	signed = append(signed, '.')
+	return append(signed, body...)
+}
+
+// VerifyWebhookSignatureWithTimestamp verifies the HMAC-SHA256 signature of a
+// webhook payload delivered with a TimestampHeader. It does not check the
+// timestamp's age.
+func VerifyWebhookSignatureWithTimestamp(body []byte, signature, timestamp, secret string) bool {
+	return verifySignature(signedPayload(timestamp, body), signature, []byte(secret))
+}
+
+// VerifyAndParseWebhook reads, verifies, and parses a webhook request for
+// handlers that don't use WebhookReceiver. The body is limited to
+// MaxWebhookBodySize. Errors are ErrWebhookBodyTooLarge, ErrMissingSignature,
+// ErrInvalidSignature, or wrap ErrInvalidPayload, and can be checked with
+// errors.Is.
+func VerifyAndParseWebhook(r *http.Request, secret string) (*WebhookNotification, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, MaxWebhookBodySize+1))
+	if err != nil {
+		return nil, fmt.Errorf("corestream: reading webhook body: %w", err)
+	}
+	if len(body) > MaxWebhookBodySize {
+		return nil, ErrWebhookBodyTooLarge
+	}
+
+	signature := r.Header.Get(SignatureHeader)
+	if signature == "" {
+		return nil, ErrMissingSignature
+	}
+	if !verifySignature(signedPayload(r.Header.Get(TimestampHeader), body), signature, []byte(secret)) {
+		return nil, ErrInvalidSignature
+	}
+
+	notification, err := ParseWebhookNotification(body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+	}
+	return notification, nil
 }
 
 // VerifyWebhookSignature verifies the HMAC-SHA256 signature of a webhook payload.
@@ -116,6 +769,37 @@ func verifySignature(body []byte, signature string, secret []byte) bool {
 	return hmac.Equal(expectedSig, computedSig)
 }
 
+// ParseWebhookNotificationDigest parses a digest webhook payload.
+// This is useful for manual webhook handling outside of WebhookReceiver.
+func ParseWebhookNotificationDigest(body []byte) (*WebhookNotificationDigest, error) {
+	var digest WebhookNotificationDigest
+	if err := json.Unmarshal(body, &digest); err != nil {
+		return nil, err
+	}
+	return &digest, nil
+}
+
+// ParseWebhookNotificationBatch parses a batch webhook payload, which is a
+// JSON array of notifications.
+// This is useful for manual webhook handling outside of WebhookReceiver.
+func ParseWebhookNotificationBatch(body []byte) ([]WebhookNotification, error) {
+	var notifications []WebhookNotification
+	if err := json.Unmarshal(body, &notifications); err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+// ParseWebhookEvent parses a non-notification event payload.
+// This is useful for manual webhook handling outside of WebhookReceiver.
+func ParseWebhookEvent(body []byte) (*WebhookEvent, error) {
+	var event WebhookEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
 // ParseWebhookNotification parses a webhook payload into a WebhookNotification.
 // This is useful for manual webhook handling outside of WebhookReceiver.
 func ParseWebhookNotification(body []byte) (*WebhookNotification, error) {
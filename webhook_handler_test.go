@@ -2,12 +2,16 @@ package corestream
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 	"time"
 )
@@ -82,6 +86,16 @@ func TestParseWebhookNotification(t *testing.T) {
 		}
 	})
 
+	t.Run("priority", func(t *testing.T) {
+		result, err := ParseWebhookNotification([]byte(`{"id":"notif_1","priority":"high"}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Priority != PriorityHigh {
+			t.Errorf("expected priority high, got %q", result.Priority)
+		}
+	})
+
 	t.Run("invalid JSON", func(t *testing.T) {
 		_, err := ParseWebhookNotification([]byte(`{invalid json`))
 		if err == nil {
@@ -296,3 +310,654 @@ func TestWebhookReceiver_ServeHTTP(t *testing.T) {
 		}
 	})
 }
+
+func TestWebhookReceiver_Digest(t *testing.T) {
+	secret := "test-secret"
+	body := []byte(`{
+		"id": "digest_1",
+		"type": "digest",
+		"alert_id": "alert_456",
+		"period_start": "2026-01-01T10:00:00Z",
+		"period_end": "2026-01-01T11:00:00Z",
+		"total_matches": 3,
+		"notifications": [
+			{"id": "notif_1", "alert_id": "alert_456", "matched_phrase": "acme"},
+			{"id": "notif_2", "alert_id": "alert_456", "matched_phrase": "acme"}
+		]
+	}`)
+
+	send := func(receiver *WebhookReceiver) int {
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+		req.Header.Set(SignatureHeader, generateSignature(body, secret))
+		rec := httptest.NewRecorder()
+		receiver.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	t.Run("digest handler", func(t *testing.T) {
+		var got *WebhookNotificationDigest
+		receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+			t.Error("notification handler should not be called")
+			return nil
+		}, WithDigestHandler(func(d *WebhookNotificationDigest) error {
+			got = d
+			return nil
+		}))
+
+		if code := send(receiver); code != http.StatusOK {
+			t.Errorf("expected status 200, got %d", code)
+		}
+		if got == nil || got.ID != "digest_1" || len(got.Notifications) != 2 {
+			t.Fatalf("unexpected digest: %+v", got)
+		}
+		if !got.Truncated() {
+			t.Error("expected digest to be truncated")
+		}
+	})
+
+	t.Run("falls back to notification handler", func(t *testing.T) {
+		var ids []string
+		receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+			ids = append(ids, n.ID)
+			return nil
+		})
+
+		if code := send(receiver); code != http.StatusOK {
+			t.Errorf("expected status 200, got %d", code)
+		}
+		if len(ids) != 2 || ids[0] != "notif_1" || ids[1] != "notif_2" {
+			t.Errorf("expected notif_1 and notif_2, got %v", ids)
+		}
+	})
+}
+
+func TestDigestConfig_JSON(t *testing.T) {
+	data, err := json.Marshal(DigestConfig{Interval: 15 * time.Minute, MaxItems: 50})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != `{"interval_seconds":900,"max_items":50}` {
+		t.Errorf("unexpected JSON: %s", data)
+	}
+
+	var d DigestConfig
+	if err := json.Unmarshal(data, &d); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.Interval != 15*time.Minute || d.MaxItems != 50 {
+		t.Errorf("unexpected round trip: %+v", d)
+	}
+}
+
+func TestWebhookReceiver_PreviousSecret(t *testing.T) {
+	body := []byte(`{"id":"notif_1"}`)
+	handler := func(n *WebhookNotification) error { return nil }
+
+	tests := []struct {
+		name   string
+		secret string
+		until  time.Time
+		want   int
+	}{
+		{"current secret", "new-secret", time.Now().Add(time.Hour), http.StatusOK},
+		{"previous secret in window", "old-secret", time.Now().Add(time.Hour), http.StatusOK},
+		{"previous secret without expiry", "old-secret", time.Time{}, http.StatusOK},
+		{"previous secret expired", "old-secret", time.Now().Add(-time.Minute), http.StatusUnauthorized},
+		{"unknown secret", "other-secret", time.Now().Add(time.Hour), http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receiver := NewWebhookReceiver("new-secret", handler, WithPreviousSecret("old-secret", tt.until))
+
+			req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+			req.Header.Set(SignatureHeader, generateSignature(body, tt.secret))
+			rec := httptest.NewRecorder()
+			receiver.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Errorf("expected status %d, got %d", tt.want, rec.Code)
+			}
+		})
+	}
+}
+
+func TestWebhookReceiver_EmptyPreviousSecret(t *testing.T) {
+	body := []byte(`{"id":"notif_1"}`)
+	receiver := NewWebhookReceiver("new-secret", func(n *WebhookNotification) error {
+		t.Error("handler should not be called")
+		return nil
+	}, WithPreviousSecret("", time.Now().Add(time.Hour)))
+
+	if code := sendSignedWebhook(receiver, body, ""); code != http.StatusUnauthorized {
+		t.Errorf("expected status 401 for empty-key signature, got %d", code)
+	}
+}
+
+func TestWebhookReceiver_Events(t *testing.T) {
+	secret := "test-secret"
+	send := func(receiver *WebhookReceiver, body []byte) int {
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+		req.Header.Set(SignatureHeader, generateSignature(body, secret))
+		rec := httptest.NewRecorder()
+		receiver.ServeHTTP(rec, req)
+		return rec.Code
+	}
+	alertUpdated := []byte(`{"id":"evt_1","event":"alert.updated","alert_id":"alert_1","data":{"id":"alert_1","name":"Renamed"}}`)
+
+	t.Run("event handler", func(t *testing.T) {
+		var got *WebhookEvent
+		receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+			t.Error("notification handler should not be called")
+			return nil
+		}, WithEventHandler(func(e *WebhookEvent) error {
+			got = e
+			return nil
+		}))
+
+		if code := send(receiver, alertUpdated); code != http.StatusOK {
+			t.Errorf("expected status 200, got %d", code)
+		}
+		if got == nil || got.Event != EventAlertUpdated {
+			t.Fatalf("unexpected event %+v", got)
+		}
+		var alert Alert
+		if err := json.Unmarshal(got.Data, &alert); err != nil || alert.Name != "Renamed" {
+			t.Errorf("unexpected event data %s", got.Data)
+		}
+	})
+
+	t.Run("unhandled events are dropped", func(t *testing.T) {
+		receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+			t.Error("notification handler should not be called")
+			return nil
+		})
+		if code := send(receiver, alertUpdated); code != http.StatusOK {
+			t.Errorf("expected status 200, got %d", code)
+		}
+	})
+
+	t.Run("notification event", func(t *testing.T) {
+		called := false
+		receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+			called = n.Event == EventNotificationCreated
+			return nil
+		})
+		send(receiver, []byte(`{"id":"notif_1","event":"notification.created"}`))
+		if !called {
+			t.Error("expected notification handler to be called")
+		}
+	})
+}
+
+func TestWebhookReceiver_Secrets(t *testing.T) {
+	body := []byte(`{"id":"notif_1"}`)
+	receiver := NewWebhookReceiver("", func(n *WebhookNotification) error { return nil },
+		WithSecrets(map[string]string{"key_1": "old-secret", "key_2": "new-secret", "key_empty": ""}))
+
+	tests := []struct {
+		name   string
+		keyID  string
+		secret string
+		want   int
+	}{
+		{"old key", "key_1", "old-secret", http.StatusOK},
+		{"new key", "key_2", "new-secret", http.StatusOK},
+		{"wrong secret for key", "key_1", "new-secret", http.StatusUnauthorized},
+		{"unknown key", "key_3", "new-secret", http.StatusUnauthorized},
+		{"no key ID with empty default secret", "", "", http.StatusUnauthorized},
+		{"empty-key signature for empty secret", "key_empty", "", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+			req.Header.Set(SignatureHeader, generateSignature(body, tt.secret))
+			if tt.keyID != "" {
+				req.Header.Set(SignatureKeyHeader, tt.keyID)
+			}
+			rec := httptest.NewRecorder()
+			receiver.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Errorf("expected status %d, got %d", tt.want, rec.Code)
+			}
+		})
+	}
+}
+
+func TestWebhookReceiver_Batch(t *testing.T) {
+	secret := "test-secret"
+	body := []byte(` [{"id":"notif_1","alert_id":"alert_1"},{"id":"notif_2","alert_id":"alert_1"}]`)
+	send := func(receiver *WebhookReceiver) int {
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+		req.Header.Set(SignatureHeader, generateSignature(body, secret))
+		rec := httptest.NewRecorder()
+		receiver.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	t.Run("batch handler", func(t *testing.T) {
+		var got []WebhookNotification
+		receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+			t.Error("notification handler should not be called")
+			return nil
+		}, WithBatchHandler(func(notifications []WebhookNotification) error {
+			got = notifications
+			return nil
+		}))
+
+		if code := send(receiver); code != http.StatusOK {
+			t.Errorf("expected status 200, got %d", code)
+		}
+		if len(got) != 2 || got[1].ID != "notif_2" {
+			t.Errorf("unexpected batch %+v", got)
+		}
+	})
+
+	t.Run("falls back to notification handler", func(t *testing.T) {
+		var ids []string
+		receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+			ids = append(ids, n.ID)
+			return nil
+		})
+
+		if code := send(receiver); code != http.StatusOK {
+			t.Errorf("expected status 200, got %d", code)
+		}
+		if len(ids) != 2 {
+			t.Errorf("expected 2 notifications, got %v", ids)
+		}
+	})
+}
+
+func TestWebhookBatchConfig_JSON(t *testing.T) {
+	data, err := json.Marshal(WebhookBatchConfig{MaxSize: 100, MaxWait: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != `{"max_size":100,"max_wait_ms":5000}` {
+		t.Errorf("unexpected JSON: %s", data)
+	}
+}
+
+func TestWebhookReceiver_TimestampTolerance(t *testing.T) {
+	secret := "test-secret"
+	body := []byte(`{"id":"notif_1"}`)
+	receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error { return nil },
+		WithTimestampTolerance(5*time.Minute))
+
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	stale := strconv.FormatInt(time.Now().Add(-10*time.Minute).Unix(), 10)
+
+	tests := []struct {
+		name      string
+		timestamp string
+		signedTS  string
+		want      int
+	}{
+		{"fresh", now, now, http.StatusOK},
+		{"stale", stale, stale, http.StatusUnauthorized},
+		{"missing", "", "", http.StatusUnauthorized},
+		{"not a number", "yesterday", "yesterday", http.StatusUnauthorized},
+		{"timestamp swapped after signing", now, stale, http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+			req.Header.Set(SignatureHeader, generateSignature(signedPayload(tt.signedTS, body), secret))
+			if tt.timestamp != "" {
+				req.Header.Set(TimestampHeader, tt.timestamp)
+			}
+			rec := httptest.NewRecorder()
+			receiver.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Errorf("expected status %d, got %d", tt.want, rec.Code)
+			}
+		})
+	}
+}
+
+func TestVerifyWebhookSignatureWithTimestamp(t *testing.T) {
+	body := []byte(`{"id":"test"}`)
+	signature := generateSignature([]byte(`1700000000.{"id":"test"}`), "secret")
+
+	if !VerifyWebhookSignatureWithTimestamp(body, signature, "1700000000", "secret") {
+		t.Error("expected signature to be valid")
+	}
+	if VerifyWebhookSignature(body, signature, "secret") {
+		t.Error("expected signature without timestamp to be invalid")
+	}
+}
+
+func TestNewWebhookReceiverContext(t *testing.T) {
+	secret := "test-secret"
+	body := []byte(`{"id":"notif_1"}`)
+
+	var info *DeliveryInfo
+	var ctxErr error
+	receiver := NewWebhookReceiverContext(secret, func(ctx context.Context, n *WebhookNotification) error {
+		info = DeliveryInfoFromContext(ctx)
+		ctxErr = ctx.Err()
+		return nil
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+	req.Header.Set(SignatureHeader, generateSignature(body, secret))
+	req.Header.Set("X-Request-Id", "req_42")
+	req.RemoteAddr = "203.0.113.7:41234"
+	rec := httptest.NewRecorder()
+	receiver.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rec.Code)
+	}
+	if ctxErr != nil {
+		t.Errorf("unexpected context error: %v", ctxErr)
+	}
+	if info == nil {
+		t.Fatal("expected delivery info in context")
+	}
+	if info.RemoteAddr != "203.0.113.7:41234" || info.Header.Get("X-Request-Id") != "req_42" {
+		t.Errorf("unexpected delivery info %+v", info)
+	}
+	if DeliveryInfoFromContext(context.Background()) != nil {
+		t.Error("expected no delivery info in a plain context")
+	}
+}
+
+func TestWebhookReceiver_RawBody(t *testing.T) {
+	secret := "test-secret"
+	body := []byte(`{"id":"notif_1","alert_id":"alert_1","future_field":{"x":1}}`)
+
+	var raw json.RawMessage
+	var signed []byte
+	receiver := NewWebhookReceiverContext(secret, func(ctx context.Context, n *WebhookNotification) error {
+		raw = n.Raw
+		signed = DeliveryInfoFromContext(ctx).Body
+		return nil
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+	req.Header.Set(SignatureHeader, generateSignature(body, secret))
+	receiver.ServeHTTP(httptest.NewRecorder(), req)
+
+	if !bytes.Equal(raw, body) {
+		t.Errorf("expected raw notification %s, got %s", body, raw)
+	}
+	if !VerifyWebhookSignature(signed, generateSignature(body, secret), secret) {
+		t.Error("expected delivery body to re-verify")
+	}
+}
+
+func TestWebhookNotification_RawInBatch(t *testing.T) {
+	notifications, err := ParseWebhookNotificationBatch([]byte(`[{"id":"a"},{"id":"b","extra":true}]`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(notifications[1].Raw) != `{"id":"b","extra":true}` {
+		t.Errorf("unexpected raw %s", notifications[1].Raw)
+	}
+}
+
+func TestWebhookReceiver_Logger(t *testing.T) {
+	secret := "test-secret"
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+		return errors.New("downstream unavailable")
+	}, WithReceiverLogger(logger))
+
+	body := []byte(`{"id":"notif_1","alert_id":"alert_9"}`)
+	sendSignedWebhook(receiver, body, secret)
+	sendSignedWebhook(receiver, body, "wrong-secret")
+	sendSignedWebhook(receiver, []byte(`{broken`), secret)
+
+	var entries []map[string]any
+	for _, line := range bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n")) {
+		var entry map[string]any
+		if err := json.Unmarshal(line, &entry); err != nil {
+			t.Fatalf("invalid log line %s", line)
+		}
+		entries = append(entries, entry)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 log entries, got %d: %s", len(entries), buf.String())
+	}
+	if entries[0]["msg"] != "webhook handler failed" || entries[0]["notification_id"] != "notif_1" || entries[0]["alert_id"] != "alert_9" {
+		t.Errorf("unexpected handler error entry %v", entries[0])
+	}
+	if entries[1]["msg"] != "webhook signature rejected" {
+		t.Errorf("unexpected signature entry %v", entries[1])
+	}
+	if entries[2]["msg"] != "invalid webhook payload" {
+		t.Errorf("unexpected payload entry %v", entries[2])
+	}
+}
+
+func TestVerifyAndParseWebhook(t *testing.T) {
+	secret := "test-secret"
+	body := []byte(`{"id":"notif_1","alert_id":"alert_1"}`)
+
+	newRequest := func(body []byte, signature string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+		if signature != "" {
+			req.Header.Set(SignatureHeader, signature)
+		}
+		return req
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		n, err := VerifyAndParseWebhook(newRequest(body, generateSignature(body, secret)), secret)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n.ID != "notif_1" {
+			t.Errorf("expected notif_1, got %s", n.ID)
+		}
+	})
+
+	large := bytes.Repeat([]byte(" "), MaxWebhookBodySize+1)
+	notJSON := []byte(`not json`)
+	tests := []struct {
+		name string
+		req  *http.Request
+		want error
+	}{
+		{"missing signature", newRequest(body, ""), ErrMissingSignature},
+		{"invalid signature", newRequest(body, generateSignature(body, "wrong")), ErrInvalidSignature},
+		{"too large", newRequest(large, generateSignature(large, secret)), ErrWebhookBodyTooLarge},
+		{"invalid payload", newRequest(notJSON, generateSignature(notJSON, secret)), ErrInvalidPayload},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := VerifyAndParseWebhook(tt.req, secret)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestWebhookReceiver_On(t *testing.T) {
+	secret := "test-secret"
+	var got []EventType
+	receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+		t.Error("notification handler should not be called for routed events")
+		return nil
+	})
+	receiver.On(EventNotificationCreated, func(ctx context.Context, e *WebhookEvent) error {
+		n, err := e.Notification()
+		if err != nil {
+			return err
+		}
+		if n.MatchedPhrase != "hello" {
+			t.Errorf("expected matched phrase 'hello', got %q", n.MatchedPhrase)
+		}
+		got = append(got, EventNotificationCreated)
+		return nil
+	})
+	receiver.On(EventNotificationDigest, func(ctx context.Context, e *WebhookEvent) error {
+		d, err := e.Digest()
+		if err != nil {
+			return err
+		}
+		if len(d.Notifications) != 1 {
+			t.Errorf("expected 1 digest notification, got %d", len(d.Notifications))
+		}
+		got = append(got, EventNotificationDigest)
+		return nil
+	})
+	receiver.On(EventWebhookTest, func(ctx context.Context, e *WebhookEvent) error {
+		got = append(got, e.Event)
+		return nil
+	})
+
+	bodies := []string{
+		`{"id":"n1","alert_id":"a1","matched_phrase":"hello"}`,
+		`{"id":"d1","type":"digest","alert_id":"a1","notifications":[{"id":"n2"}]}`,
+		`{"id":"t1","event":"webhook.test"}`,
+	}
+	for _, body := range bodies {
+		if code := sendSignedWebhook(receiver, []byte(body), secret); code != http.StatusOK {
+			t.Errorf("expected status 200 for %s, got %d", body, code)
+		}
+	}
+
+	want := []EventType{EventNotificationCreated, EventNotificationDigest, EventWebhookTest}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d routed events, got %v", len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("event %d: expected %s, got %s", i, want[i], got[i])
+		}
+	}
+}
+
+func TestWebhookReceiver_OnUnknown(t *testing.T) {
+	secret := "test-secret"
+	var alertUpdates, unknown []string
+	receiver := NewWebhookReceiver(secret, nil)
+	receiver.On(EventAlertUpdated, func(ctx context.Context, e *WebhookEvent) error {
+		alertUpdates = append(alertUpdates, e.AlertID)
+		return nil
+	})
+	receiver.OnUnknown(func(ctx context.Context, e *WebhookEvent) error {
+		unknown = append(unknown, e.ID)
+		return nil
+	})
+
+	bodies := []string{
+		`{"id":"e1","event":"alert.updated","alert_id":"a1"}`,
+		`{"id":"e2","event":"stream.processed","stream_id":"s1"}`,
+		`{"id":"n1","alert_id":"a1","matched_phrase":"hello"}`,
+	}
+	for _, body := range bodies {
+		if code := sendSignedWebhook(receiver, []byte(body), secret); code != http.StatusOK {
+			t.Errorf("expected status 200 for %s, got %d", body, code)
+		}
+	}
+
+	if len(alertUpdates) != 1 || alertUpdates[0] != "a1" {
+		t.Errorf("expected one alert update for a1, got %v", alertUpdates)
+	}
+	if len(unknown) != 2 || unknown[0] != "e2" || unknown[1] != "n1" {
+		t.Errorf("expected unknown deliveries [e2 n1], got %v", unknown)
+	}
+}
+
+func TestWebhookReceiver_OnUnknownError(t *testing.T) {
+	secret := "test-secret"
+	receiver := NewWebhookReceiver(secret, nil)
+	receiver.OnUnknown(func(ctx context.Context, e *WebhookEvent) error {
+		return errors.New("boom")
+	})
+
+	body := []byte(`{"id":"e1","event":"stream.processed"}`)
+	if code := sendSignedWebhook(receiver, body, secret); code != http.StatusInternalServerError {
+		t.Errorf("expected status 500, got %d", code)
+	}
+}
+
+func TestWebhookReceiver_BatchWithoutHandler(t *testing.T) {
+	secret := "test-secret"
+	body := []byte(`[{"id":"n1","alert_id":"a1"},{"id":"n2","alert_id":"a1"}]`)
+
+	var routed []string
+	receiver := NewWebhookReceiver(secret, nil)
+	receiver.On(EventNotificationCreated, func(ctx context.Context, e *WebhookEvent) error {
+		routed = append(routed, e.ID)
+		return nil
+	})
+	if code := sendSignedWebhook(receiver, body, secret); code != http.StatusOK {
+		t.Errorf("expected status 200, got %d", code)
+	}
+	if len(routed) != 2 || routed[0] != "n1" || routed[1] != "n2" {
+		t.Errorf("expected routed notifications [n1 n2], got %v", routed)
+	}
+
+	var unknown []string
+	receiver = NewWebhookReceiver(secret, nil)
+	receiver.OnUnknown(func(ctx context.Context, e *WebhookEvent) error {
+		unknown = append(unknown, e.ID)
+		return nil
+	})
+	if code := sendSignedWebhook(receiver, body, secret); code != http.StatusOK {
+		t.Errorf("expected status 200, got %d", code)
+	}
+	if len(unknown) != 2 {
+		t.Errorf("expected 2 unknown deliveries, got %v", unknown)
+	}
+
+	receiver = NewWebhookReceiver(secret, nil)
+	receiver.On(EventAlertUpdated, func(ctx context.Context, e *WebhookEvent) error {
+		t.Error("alert.updated handler should not receive notifications")
+		return nil
+	})
+	if code := sendSignedWebhook(receiver, body, secret); code != http.StatusOK {
+		t.Errorf("expected dropped batch to be acknowledged, got %d", code)
+	}
+}
+
+func TestWebhookReceiver_NotificationValidation(t *testing.T) {
+	secret := "test-secret"
+	var handled int
+	receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+		handled++
+		return nil
+	}, WithNotificationValidation())
+
+	tests := []struct {
+		name string
+		body string
+		want int
+	}{
+		{"valid", `{"id":"n1","alert_id":"a1","timestamp":"2024-01-01T00:00:00Z"}`, http.StatusOK},
+		{"missing fields", `{"id":"n2"}`, http.StatusBadRequest},
+		{"batch with invalid notification", `[{"id":"n3","alert_id":"a1","timestamp":"2024-01-01T00:00:00Z"},{"id":"n4"}]`, http.StatusBadRequest},
+		{"digest with invalid notification", `{"id":"d1","type":"digest","notifications":[{"alert_id":"a1"}]}`, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := sendSignedWebhook(receiver, []byte(tt.body), secret); code != tt.want {
+				t.Errorf("expected status %d, got %d", tt.want, code)
+			}
+		})
+	}
+	if handled != 1 {
+		t.Errorf("expected only the valid notification to be handled, got %d", handled)
+	}
+}
+
+func TestWebhookReceiver_NotificationValidationAsync(t *testing.T) {
+	secret := "test-secret"
+	var got error
+	receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error { return nil },
+		WithNotificationValidation(), WithAsyncProcessing(1, 1), WithAsyncErrorHandler(func(err error) { got = err }))
+
+	sendSignedWebhook(receiver, []byte(`{"id":"n1"}`), secret)
+	receiver.Shutdown(context.Background())
+	if !errors.Is(got, ErrInvalidNotification) {
+		t.Errorf("expected ErrInvalidNotification, got %v", got)
+	}
+}
@@ -0,0 +1,85 @@
+package corestream
+
+import "time"
+
+// Reasons passed to ReceiverMetrics.DeliveryRejected.
+const (
+	RejectReasonMethod    = "method"
+	RejectReasonBody      = "body"
+	RejectReasonSignature = "signature"
+	RejectReasonPayload   = "payload"
+	RejectReasonQueueFull = "queue_full"
+	RejectReasonStore     = "store"
+	RejectReasonRateLimit = "rate_limit"
+)
+
+// Handler kinds passed to ReceiverMetrics.HandlerDone.
+const (
+	HandlerKindNotification = "notification"
+	HandlerKindDigest       = "digest"
+	HandlerKindBatch        = "batch"
+	HandlerKindEvent        = "event"
+)
+
+// ReceiverMetrics receives counts and timings from a WebhookReceiver so they
+// can be exported to a metrics system. Methods may be called concurrently.
+//
+// A Prometheus adapter might look like:
+//
+//	type promMetrics struct {
+//		received, verified prometheus.Counter
+//		rejected           *prometheus.CounterVec   // label: reason
+//		handlerDuration    *prometheus.HistogramVec // label: kind
+//		handlerErrors      *prometheus.CounterVec   // label: kind
+//	}
+//
+//	func (m *promMetrics) DeliveryReceived()              { m.received.Inc() }
+//	func (m *promMetrics) DeliveryVerified()              { m.verified.Inc() }
+//	func (m *promMetrics) DeliveryRejected(reason string) { m.rejected.WithLabelValues(reason).Inc() }
+//	func (m *promMetrics) HandlerDone(kind string, d time.Duration, err error) {
+//		m.handlerDuration.WithLabelValues(kind).Observe(d.Seconds())
+//		if err != nil {
+//			m.handlerErrors.WithLabelValues(kind).Inc()
+//		}
+//	}
+type ReceiverMetrics interface {
+	// DeliveryReceived is called for every request.
+	DeliveryReceived()
+	// DeliveryVerified is called when a request's signature is valid, or
+	// when verification is disabled.
+	DeliveryVerified()
+	// DeliveryRejected is called when a request is refused, with one of the
+	// RejectReason constants.
+	DeliveryRejected(reason string)
+	// HandlerDone is called after a handler returns, with one of the
+	// HandlerKind constants. Duplicates suppressed by WithDeduplication are
+	// not handled and not reported.
+	HandlerDone(kind string, d time.Duration, err error)
+}
+
+// WithReceiverMetrics reports receiver activity to m. A nil m is ignored.
+func WithReceiverMetrics(m ReceiverMetrics) WebhookReceiverOption {
+	return func(r *WebhookReceiver) {
+		if m != nil {
+			r.metrics = m
+		}
+	}
+}
+
+// noopMetrics is used when no ReceiverMetrics is configured.
+type noopMetrics struct{}
+
+func (noopMetrics) DeliveryReceived()                        {}
+func (noopMetrics) DeliveryVerified()                        {}
+func (noopMetrics) DeliveryRejected(string)                  {}
+func (noopMetrics) HandlerDone(string, time.Duration, error) {}
+
+// timed wraps fn to report its duration and result as a handler of kind.
+func (r *WebhookReceiver) timed(kind string, fn func() error) func() error {
+	return func() error {
+		start := time.Now()
+		err := fn()
+		r.metrics.HandlerDone(kind, time.Since(start), err)
+		return err
+	}
+}
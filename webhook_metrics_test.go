@@ -0,0 +1,92 @@
+package corestream
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recordingMetrics struct {
+	mu       sync.Mutex
+	received int
+	verified int
+	rejected map[string]int
+	handled  map[string]int
+	errors   map[string]int
+}
+
+func newRecordingMetrics() *recordingMetrics {
+	return &recordingMetrics{rejected: map[string]int{}, handled: map[string]int{}, errors: map[string]int{}}
+}
+
+func (m *recordingMetrics) DeliveryReceived() {
+	m.mu.Lock()
+	m.received++
+	m.mu.Unlock()
+}
+
+func (m *recordingMetrics) DeliveryVerified() {
+	m.mu.Lock()
+	m.verified++
+	m.mu.Unlock()
+}
+
+func (m *recordingMetrics) DeliveryRejected(reason string) {
+	m.mu.Lock()
+	m.rejected[reason]++
+	m.mu.Unlock()
+}
+
+func (m *recordingMetrics) HandlerDone(kind string, d time.Duration, err error) {
+	m.mu.Lock()
+	m.handled[kind]++
+	if err != nil {
+		m.errors[kind]++
+	}
+	m.mu.Unlock()
+}
+
+func TestWebhookReceiver_Metrics(t *testing.T) {
+	secret := "test-secret"
+	metrics := newRecordingMetrics()
+	receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+		if n.ID == "bad" {
+			return errors.New("failed")
+		}
+		return nil
+	}, WithReceiverMetrics(metrics))
+
+	sendSignedWebhook(receiver, []byte(`{"id":"good"}`), secret)
+	sendSignedWebhook(receiver, []byte(`{"id":"bad"}`), secret)
+	sendSignedWebhook(receiver, []byte(`{"id":"good"}`), "wrong-secret")
+	sendSignedWebhook(receiver, []byte(`{broken`), secret)
+	receiver.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/webhook", nil))
+
+	if metrics.received != 5 || metrics.verified != 3 {
+		t.Errorf("expected 5 received and 3 verified, got %d and %d", metrics.received, metrics.verified)
+	}
+	wantRejected := map[string]int{RejectReasonSignature: 1, RejectReasonPayload: 1, RejectReasonMethod: 1}
+	for reason, want := range wantRejected {
+		if got := metrics.rejected[reason]; got != want {
+			t.Errorf("expected %d %s rejections, got %d", want, reason, got)
+		}
+	}
+	if metrics.handled[HandlerKindNotification] != 2 || metrics.errors[HandlerKindNotification] != 1 {
+		t.Errorf("unexpected handler metrics %v %v", metrics.handled, metrics.errors)
+	}
+}
+
+func TestWithReceiverMetrics_Nil(t *testing.T) {
+	secret := "test-secret"
+	receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+		return nil
+	}, WithReceiverMetrics(nil))
+
+	body := []byte(`{"id":"n1","alert_id":"a1"}`)
+	if code := sendSignedWebhook(receiver, body, secret); code != http.StatusOK {
+		t.Errorf("expected status 200, got %d", code)
+	}
+}
@@ -0,0 +1,47 @@
+package corestream
+
+import (
+	"sync"
+	"time"
+)
+
+// WithReceiverRateLimit limits verified deliveries to rps per second on
+// average, allowing bursts of up to burst deliveries. Deliveries over the
+// limit are answered with 429 Too Many Requests so that the sender retries
+// them later, instead of passing a notification storm on to the handlers.
+// The limit applies per receiver; rps <= 0 disables it.
+func WithReceiverRateLimit(rps float64, burst int) WebhookReceiverOption {
+	return func(r *WebhookReceiver) {
+		if rps <= 0 {
+			r.rateLimiter = nil
+			return
+		}
+		b := float64(max(burst, 1))
+		r.rateLimiter = &rateLimiter{rate: rps, burst: b, tokens: b, last: time.Now()}
+	}
+}
+
+// rateLimiter is a token bucket.
+type rateLimiter struct {
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// allow takes a token if one is available.
+func (l *rateLimiter) allow() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
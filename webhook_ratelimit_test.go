@@ -0,0 +1,59 @@
+package corestream
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWebhookReceiver_RateLimit(t *testing.T) {
+	secret := "test-secret"
+	body := []byte(`{"id":"notif_1"}`)
+	var handled int
+	metrics := newRecordingMetrics()
+	receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+		handled++
+		return nil
+	}, WithReceiverRateLimit(1, 3), WithReceiverMetrics(metrics))
+
+	for i := range 3 {
+		if code := sendSignedWebhook(receiver, body, secret); code != http.StatusOK {
+			t.Errorf("delivery %d: expected status 200, got %d", i, code)
+		}
+	}
+	if code := sendSignedWebhook(receiver, body, secret); code != http.StatusTooManyRequests {
+		t.Errorf("expected status 429 after burst, got %d", code)
+	}
+	if handled != 3 {
+		t.Errorf("expected 3 handled deliveries, got %d", handled)
+	}
+	if got := metrics.rejected[RejectReasonRateLimit]; got != 1 {
+		t.Errorf("expected 1 rate limit rejection, got %d", got)
+	}
+}
+
+func TestWebhookReceiver_RateLimitUnverified(t *testing.T) {
+	secret := "test-secret"
+	receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error { return nil },
+		WithReceiverRateLimit(1, 1))
+
+	// Forged deliveries must not use up the limit.
+	for range 3 {
+		if code := sendSignedWebhook(receiver, []byte(`{"id":"notif_1"}`), "wrong-secret"); code != http.StatusUnauthorized {
+			t.Errorf("expected status 401, got %d", code)
+		}
+	}
+	if code := sendSignedWebhook(receiver, []byte(`{"id":"notif_1"}`), secret); code != http.StatusOK {
+		t.Errorf("expected status 200, got %d", code)
+	}
+}
+
+func TestRateLimiter_Refill(t *testing.T) {
+	l := &rateLimiter{rate: 10, burst: 1, tokens: 0, last: time.Now().Add(-200 * time.Millisecond)}
+	if !l.allow() {
+		t.Error("expected a token after refill")
+	}
+	if l.allow() {
+		t.Error("expected the bucket to be capped at burst")
+	}
+}
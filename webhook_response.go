@@ -0,0 +1,86 @@
+package corestream
+
+import (
+	"errors"
+	"net/http"
+)
+
+// permanentError marks handler errors that retrying will not fix.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// PermanentError wraps a handler error to tell the sender not to retry the
+// delivery. By default the receiver responds 400 Bad Request to permanent
+// errors and 500 Internal Server Error to all other handler errors.
+func PermanentError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err}
+}
+
+// IsPermanentError reports whether err was wrapped with PermanentError.
+func IsPermanentError(err error) bool {
+	var perr *permanentError
+	return errors.As(err, &perr)
+}
+
+// WithSuccessResponse sets the status code and body sent after a delivery is
+// handled, or accepted for asynchronous processing unless WithAcceptedResponse
+// is also given.
+func WithSuccessResponse(status int, body []byte) WebhookReceiverOption {
+	return func(r *WebhookReceiver) {
+		r.successStatus = status
+		r.successBody = body
+	}
+}
+
+// WithAcceptedResponse makes an asynchronous receiver answer queued
+// deliveries with 202 Accepted instead of the success status, so senders can
+// tell queued deliveries from handled ones. The body set by
+// WithSuccessResponse is still used.
+func WithAcceptedResponse() WebhookReceiverOption {
+	return func(r *WebhookReceiver) {
+		r.acceptedResponse = true
+	}
+}
+
+// WithErrorStatus sets a function mapping handler errors to response status
+// codes. Returning 0 falls back to the default mapping.
+func WithErrorStatus(fn func(err error) int) WebhookReceiverOption {
+	return func(r *WebhookReceiver) {
+		r.errorStatus = fn
+	}
+}
+
+func (r *WebhookReceiver) handlerErrorStatus(err error) int {
+	if r.errorStatus != nil {
+		if status := r.errorStatus(err); status != 0 {
+			return status
+		}
+	}
+	if IsPermanentError(err) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+func (r *WebhookReceiver) successResponse(status int, body []byte) DeliveryResponse {
+	if r.successStatus != 0 {
+		status, body = r.successStatus, r.successBody
+	}
+	return DeliveryResponse{StatusCode: status, Body: body}
+}
+
+func (r *WebhookReceiver) queuedResponse() DeliveryResponse {
+	if !r.acceptedResponse {
+		return r.successResponse(http.StatusOK, []byte(`{"status":"ok"}`))
+	}
+	resp := r.successResponse(http.StatusAccepted, []byte(`{"status":"accepted"}`))
+	resp.StatusCode = http.StatusAccepted
+	return resp
+}
@@ -0,0 +1,111 @@
+package corestream
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWebhookReceiver_SuccessResponse(t *testing.T) {
+	secret := "test-secret"
+	body := []byte(`{"id":"notif_1"}`)
+	receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error { return nil },
+		WithSuccessResponse(http.StatusNoContent, nil))
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+	req.Header.Set(SignatureHeader, generateSignature(body, secret))
+	rec := httptest.NewRecorder()
+	receiver.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("expected status 204, got %d", rec.Code)
+	}
+	if rec.Body.Len() != 0 {
+		t.Errorf("expected empty body, got %q", rec.Body.String())
+	}
+}
+
+func TestWebhookReceiver_AcceptedResponse(t *testing.T) {
+	secret := "test-secret"
+	body := []byte(`{"id":"notif_1"}`)
+
+	tests := []struct {
+		name string
+		opts []WebhookReceiverOption
+		want int
+	}{
+		{"default", nil, http.StatusOK},
+		{"success response", []WebhookReceiverOption{WithSuccessResponse(http.StatusNoContent, nil)}, http.StatusNoContent},
+		{"accepted response", []WebhookReceiverOption{WithAcceptedResponse()}, http.StatusAccepted},
+		{"accepted with success response", []WebhookReceiverOption{WithSuccessResponse(http.StatusNoContent, nil), WithAcceptedResponse()}, http.StatusAccepted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]WebhookReceiverOption{WithAsyncProcessing(1, 1)}, tt.opts...)
+			receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error { return nil }, opts...)
+			defer receiver.Shutdown(context.Background())
+
+			if code := sendSignedWebhook(receiver, body, secret); code != tt.want {
+				t.Errorf("expected status %d, got %d", tt.want, code)
+			}
+		})
+	}
+
+	// Synchronous deliveries are unaffected.
+	receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error { return nil }, WithAcceptedResponse())
+	if code := sendSignedWebhook(receiver, body, secret); code != http.StatusOK {
+		t.Errorf("expected status 200 for synchronous delivery, got %d", code)
+	}
+}
+
+func TestWebhookReceiver_ErrorStatus(t *testing.T) {
+	secret := "test-secret"
+	body := []byte(`{"id":"notif_1"}`)
+	errRateLimited := errors.New("rate limited")
+
+	tests := []struct {
+		name string
+		err  error
+		opts []WebhookReceiverOption
+		want int
+	}{
+		{"transient", errors.New("db down"), nil, http.StatusInternalServerError},
+		{"permanent", PermanentError(errors.New("unknown alert")), nil, http.StatusBadRequest},
+		{"wrapped permanent", fmt.Errorf("handling: %w", PermanentError(errors.New("bad"))), nil, http.StatusBadRequest},
+		{
+			"custom mapping",
+			errRateLimited,
+			[]WebhookReceiverOption{WithErrorStatus(func(err error) int {
+				if errors.Is(err, errRateLimited) {
+					return http.StatusTooManyRequests
+				}
+				return 0
+			})},
+			http.StatusTooManyRequests,
+		},
+		{
+			"custom mapping falls back",
+			PermanentError(errors.New("bad")),
+			[]WebhookReceiverOption{WithErrorStatus(func(err error) int { return 0 })},
+			http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error { return tt.err }, tt.opts...)
+			if code := sendSignedWebhook(receiver, body, secret); code != tt.want {
+				t.Errorf("expected status %d, got %d", tt.want, code)
+			}
+		})
+	}
+}
+
+func TestPermanentError_Nil(t *testing.T) {
+	if PermanentError(nil) != nil {
+		t.Error("expected nil")
+	}
+}
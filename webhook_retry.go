@@ -0,0 +1,44 @@
+package corestream
+
+import (
+	"context"
+	"time"
+)
+
+// WithHandlerRetries retries a failed handler up to n more times before the
+// delivery fails, waiting backoff before the first retry and doubling the
+// wait after each one. Errors wrapped with PermanentError are not retried.
+// In synchronous mode the retries hold the request open, so keep the total
+// wait well below the sender's delivery timeout.
+func WithHandlerRetries(n int, backoff time.Duration) WebhookReceiverOption {
+	return func(r *WebhookReceiver) {
+		r.handlerRetries = max(n, 0)
+		r.handlerBackoff = backoff
+	}
+}
+
+// retried wraps fn so that it is retried as configured by WithHandlerRetries.
+// It stops waiting when ctx is done and returns the last handler error.
+func (r *WebhookReceiver) retried(ctx context.Context, kind string, fn func() error) func() error {
+	if r.handlerRetries == 0 {
+		return fn
+	}
+	return func() error {
+		wait := r.handlerBackoff
+		err := fn()
+		for attempt := 1; attempt <= r.handlerRetries && err != nil && !IsPermanentError(err); attempt++ {
+			r.logger.WarnContext(ctx, "webhook handler failed, retrying",
+				"handler", kind, "attempt", attempt, "backoff", wait, "error", err)
+			t := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				return err
+			case <-t.C:
+			}
+			wait *= 2
+			err = fn()
+		}
+		return err
+	}
+}
@@ -0,0 +1,93 @@
+package corestream
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWebhookReceiver_HandlerRetries(t *testing.T) {
+	secret := "test-secret"
+	body := []byte(`{"id":"notif_1"}`)
+
+	t.Run("succeeds after transient failures", func(t *testing.T) {
+		var calls int
+		receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+			calls++
+			if calls < 3 {
+				return errors.New("database blip")
+			}
+			return nil
+		}, WithHandlerRetries(3, time.Millisecond))
+
+		if code := sendSignedWebhook(receiver, body, secret); code != http.StatusOK {
+			t.Errorf("expected status 200, got %d", code)
+		}
+		if calls != 3 {
+			t.Errorf("expected 3 calls, got %d", calls)
+		}
+	})
+
+	t.Run("fails after exhausting retries", func(t *testing.T) {
+		var calls int
+		receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+			calls++
+			return errors.New("database down")
+		}, WithHandlerRetries(2, time.Millisecond))
+
+		if code := sendSignedWebhook(receiver, body, secret); code != http.StatusInternalServerError {
+			t.Errorf("expected status 500, got %d", code)
+		}
+		if calls != 3 {
+			t.Errorf("expected 3 calls, got %d", calls)
+		}
+	})
+
+	t.Run("does not retry permanent errors", func(t *testing.T) {
+		var calls int
+		receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+			calls++
+			return PermanentError(errors.New("unknown alert"))
+		}, WithHandlerRetries(3, time.Millisecond))
+
+		if code := sendSignedWebhook(receiver, body, secret); code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", code)
+		}
+		if calls != 1 {
+			t.Errorf("expected 1 call, got %d", calls)
+		}
+	})
+
+	t.Run("records each attempt", func(t *testing.T) {
+		metrics := newRecordingMetrics()
+		receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+			return errors.New("database down")
+		}, WithHandlerRetries(1, time.Millisecond), WithReceiverMetrics(metrics))
+
+		sendSignedWebhook(receiver, body, secret)
+		if got := metrics.errors[HandlerKindNotification]; got != 2 {
+			t.Errorf("expected 2 failed attempts recorded, got %d", got)
+		}
+	})
+}
+
+func TestWebhookReceiver_HandlerRetriesContextDone(t *testing.T) {
+	handlerErr := errors.New("database down")
+	var calls int
+	receiver := NewWebhookReceiver("test-secret", func(n *WebhookNotification) error {
+		calls++
+		return handlerErr
+	}, WithoutSignatureVerification(), WithHandlerRetries(3, time.Hour))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	resp := receiver.HandleDelivery(ctx, Delivery{Body: []byte(`{"id":"notif_1"}`)})
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("expected status 500, got %d", resp.StatusCode)
+	}
+	if calls != 1 {
+		t.Errorf("expected no retry after the context is done, got %d calls", calls)
+	}
+}
@@ -0,0 +1,102 @@
+package corestream
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+// StoredDelivery is a verified webhook delivery saved by a NotificationStore.
+type StoredDelivery struct {
+	// ID is the hex-encoded SHA-256 of Body, so retries of a delivery share an ID.
+	ID         string
+	ReceivedAt time.Time
+	Body       []byte
+}
+
+// NotificationStore persists verified deliveries before they are
+// acknowledged, so that deliveries accepted before a crash can be processed
+// with WebhookReceiver.ProcessPending after a restart. See
+// examples/webhook/store.go for an implementation using database/sql.
+type NotificationStore interface {
+	// Save persists d. Saving an ID that is already stored must succeed.
+	Save(ctx context.Context, d StoredDelivery) error
+	// Complete marks the delivery with the given ID as processed.
+	Complete(ctx context.Context, id string) error
+	// Pending returns the deliveries that were saved but not completed.
+	Pending(ctx context.Context) ([]StoredDelivery, error)
+}
+
+// WithStore saves every verified delivery to store before it is handled or
+// queued, and marks it complete once the handlers succeed. If Save fails the
+// delivery is answered with 503 so that it is retried. Deliveries whose
+// handlers fail stay pending; combine WithStore with WithDeduplication if the
+// sender's retries and ProcessPending may overlap.
+func WithStore(store NotificationStore) WebhookReceiverOption {
+	return func(r *WebhookReceiver) {
+		r.store = store
+	}
+}
+
+// save persists body and returns its store ID, or "" without a store.
+func (r *WebhookReceiver) save(ctx context.Context, body []byte) (string, error) {
+	if r.store == nil {
+		return "", nil
+	}
+	sum := sha256.Sum256(body)
+	id := hex.EncodeToString(sum[:])
+	if err := r.store.Save(ctx, StoredDelivery{ID: id, ReceivedAt: time.Now(), Body: body}); err != nil {
+		r.logger.ErrorContext(ctx, "failed to store webhook delivery", "error", err)
+		r.metrics.DeliveryRejected(RejectReasonStore)
+		return "", err
+	}
+	return id, nil
+}
+
+// complete marks a stored delivery as processed. Failures are only logged:
+// the delivery has been handled, and at worst ProcessPending handles it again.
+func (r *WebhookReceiver) complete(ctx context.Context, id string) {
+	if id == "" {
+		return
+	}
+	if err := r.store.Complete(context.WithoutCancel(ctx), id); err != nil {
+		r.logger.ErrorContext(ctx, "failed to complete stored webhook delivery", "store_id", id, "error", err)
+	}
+}
+
+// settled reports whether a delivery whose handling returned err is finished:
+// it was handled, or it can't be parsed and would never succeed.
+func settled(err error) bool {
+	var payloadErr *payloadError
+	return err == nil || errors.As(err, &payloadErr)
+}
+
+// ProcessPending passes each pending delivery in the store to the handlers
+// and marks those that succeed, or can't be parsed, as complete. Call it at startup, before
+// serving requests, to recover deliveries accepted before a crash. It returns
+// the handler errors joined together. Without WithStore it does nothing.
+func (r *WebhookReceiver) ProcessPending(ctx context.Context) error {
+	if r.store == nil {
+		return nil
+	}
+	pending, err := r.store.Pending(ctx)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, d := range pending {
+		if err := ctx.Err(); err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+		err := r.dispatch(ctx, d.Body)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		if settled(err) {
+			r.complete(ctx, d.ID)
+		}
+	}
+	return errors.Join(errs...)
+}
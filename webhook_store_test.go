@@ -0,0 +1,167 @@
+package corestream
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testStore struct {
+	mu        sync.Mutex
+	saved     map[string]StoredDelivery
+	completed map[string]bool
+	saveErr   error
+}
+
+func newTestStore() *testStore {
+	return &testStore{saved: make(map[string]StoredDelivery), completed: make(map[string]bool)}
+}
+
+func (s *testStore) Save(_ context.Context, d StoredDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.saveErr != nil {
+		return s.saveErr
+	}
+	s.saved[d.ID] = d
+	return nil
+}
+
+func (s *testStore) Complete(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.completed[id] = true
+	return nil
+}
+
+func (s *testStore) Pending(_ context.Context) ([]StoredDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var pending []StoredDelivery
+	for id, d := range s.saved {
+		if !s.completed[id] {
+			pending = append(pending, d)
+		}
+	}
+	return pending, nil
+}
+
+func (s *testStore) counts() (saved, completed int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.saved), len(s.completed)
+}
+
+func TestWebhookReceiver_Store(t *testing.T) {
+	secret := "test-secret"
+	store := newTestStore()
+	var stored bool
+	receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+		saved, _ := store.counts()
+		stored = saved == 1
+		return nil
+	}, WithStore(store))
+
+	body := []byte(`{"id":"n1","alert_id":"a1"}`)
+	if code := sendSignedWebhook(receiver, body, secret); code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", code)
+	}
+	if !stored {
+		t.Error("expected delivery to be stored before the handler ran")
+	}
+	if saved, completed := store.counts(); saved != 1 || completed != 1 {
+		t.Errorf("expected 1 saved and 1 completed, got %d and %d", saved, completed)
+	}
+}
+
+func TestWebhookReceiver_StoreHandlerError(t *testing.T) {
+	secret := "test-secret"
+	store := newTestStore()
+	fail := true
+	var handled int
+	receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+		if fail {
+			return errors.New("boom")
+		}
+		handled++
+		return nil
+	}, WithStore(store))
+
+	body := []byte(`{"id":"n1","alert_id":"a1"}`)
+	if code := sendSignedWebhook(receiver, body, secret); code != http.StatusInternalServerError {
+		t.Fatalf("expected status 500, got %d", code)
+	}
+	pending, _ := store.Pending(context.Background())
+	if len(pending) != 1 {
+		t.Fatalf("expected 1 pending delivery, got %d", len(pending))
+	}
+
+	fail = false
+	if err := receiver.ProcessPending(context.Background()); err != nil {
+		t.Fatalf("ProcessPending failed: %v", err)
+	}
+	if handled != 1 {
+		t.Errorf("expected pending delivery to be handled once, got %d", handled)
+	}
+	pending, _ = store.Pending(context.Background())
+	if len(pending) != 0 {
+		t.Errorf("expected no pending deliveries, got %d", len(pending))
+	}
+}
+
+func TestWebhookReceiver_StoreSaveError(t *testing.T) {
+	secret := "test-secret"
+	store := newTestStore()
+	store.saveErr = errors.New("database down")
+	metrics := newRecordingMetrics()
+	receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error {
+		t.Error("handler should not run when the delivery can't be stored")
+		return nil
+	}, WithStore(store), WithReceiverMetrics(metrics))
+
+	body := []byte(`{"id":"n1","alert_id":"a1"}`)
+	if code := sendSignedWebhook(receiver, body, secret); code != http.StatusServiceUnavailable {
+		t.Errorf("expected status 503, got %d", code)
+	}
+	if metrics.rejected[RejectReasonStore] != 1 {
+		t.Errorf("expected 1 store rejection, got %d", metrics.rejected[RejectReasonStore])
+	}
+}
+
+func TestWebhookReceiver_StoreInvalidPayload(t *testing.T) {
+	secret := "test-secret"
+	store := newTestStore()
+	receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error { return nil }, WithStore(store))
+
+	body := []byte(`{"id":`)
+	if code := sendSignedWebhook(receiver, body, secret); code != http.StatusBadRequest {
+		t.Errorf("expected status 400, got %d", code)
+	}
+	pending, _ := store.Pending(context.Background())
+	if len(pending) != 0 {
+		t.Errorf("expected invalid payload not to stay pending, got %d", len(pending))
+	}
+}
+
+func TestWebhookReceiver_StoreAsync(t *testing.T) {
+	secret := "test-secret"
+	store := newTestStore()
+	receiver := NewWebhookReceiver(secret, func(n *WebhookNotification) error { return nil },
+		WithStore(store), WithAsyncProcessing(1, 10))
+
+	body := []byte(`{"id":"n1","alert_id":"a1"}`)
+	if code := sendSignedWebhook(receiver, body, secret); code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", code)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := receiver.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown failed: %v", err)
+	}
+	if saved, completed := store.counts(); saved != 1 || completed != 1 {
+		t.Errorf("expected 1 saved and 1 completed, got %d and %d", saved, completed)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // CreateWebhook creates a webhook for an alert.
@@ -36,12 +37,65 @@ func (c *Client) UpdateWebhook(ctx context.Context, alertID string, req *UpdateW
 	return &webhook, nil
 }
 
+// SetWebhookActive pauses or resumes delivery for an alert's webhook without
+// changing the rest of its configuration.
+func (c *Client) SetWebhookActive(ctx context.Context, alertID string, active bool) (*Webhook, error) {
+	path := fmt.Sprintf("/v2/alerts/%s/webhook/active", alertID)
+	var webhook Webhook
+	if err := c.request(ctx, http.MethodPut, path, nil, &SetWebhookActiveRequest{IsActive: active}, &webhook); err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
 // DeleteWebhook removes the webhook configuration from an alert.
 func (c *Client) DeleteWebhook(ctx context.Context, alertID string) error {
 	path := fmt.Sprintf("/v2/alerts/%s/webhook", alertID)
 	return c.request(ctx, http.MethodDelete, path, nil, nil, nil)
 }
 
+// GetWebhookSecret reveals the secret currently used to sign an alert's
+// webhook deliveries, e.g. to check configuration drift. GetWebhook never
+// returns the secret.
+func (c *Client) GetWebhookSecret(ctx context.Context, alertID string) (*WebhookSecretResponse, error) {
+	path := fmt.Sprintf("/v2/alerts/%s/webhook/secret", alertID)
+	var resp WebhookSecretResponse
+	if err := c.request(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RotateWebhookSecret replaces the alert's webhook secret with a new one
+// generated by the server. The new secret is only returned by this call, so
+// store it before discarding the response. Deliveries are signed with the new
+// secret immediately and carry its key ID in SignatureKeyHeader; use
+// WithSecrets or WithPreviousSecret on the receiver to keep accepting
+// in-flight retries signed with the old one.
+func (c *Client) RotateWebhookSecret(ctx context.Context, alertID string) (*RotateWebhookSecretResponse, error) {
+	path := fmt.Sprintf("/v2/alerts/%s/webhook/rotate-secret", alertID)
+	var resp RotateWebhookSecretResponse
+	if err := c.request(ctx, http.MethodPost, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetWebhookStats returns delivery statistics for an alert's webhook over
+// period. An empty period uses the API default of StatsPeriodDay.
+func (c *Client) GetWebhookStats(ctx context.Context, alertID string, period StatsPeriod) (*WebhookStats, error) {
+	path := fmt.Sprintf("/v2/alerts/%s/webhook/stats", alertID)
+	query := url.Values{}
+	if period != "" {
+		query.Set("period", string(period))
+	}
+	var stats WebhookStats
+	if err := c.request(ctx, http.MethodGet, path, query, nil, &stats); err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
 // TestWebhook sends a test webhook notification.
 // If req is nil, tests the saved webhook configuration.
 // If req is provided, tests with the specified URL/secret.
@@ -49,3 +103,48 @@ func (c *Client) TestWebhook(ctx context.Context, alertID string, req *TestWebho
 	path := fmt.Sprintf("/v2/alerts/%s/webhook/test", alertID)
 	return c.request(ctx, http.MethodPost, path, nil, req, nil)
 }
+
+// CreateGlobalWebhook creates an account-level webhook that receives
+// notifications from every alert, including alerts created later.
+func (c *Client) CreateGlobalWebhook(ctx context.Context, req *CreateWebhookRequest) (*Webhook, error) {
+	var webhook Webhook
+	if err := c.request(ctx, http.MethodPost, "/v2/webhooks", nil, req, &webhook); err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// ListGlobalWebhooks returns the account-level webhooks.
+func (c *Client) ListGlobalWebhooks(ctx context.Context) (*ListGlobalWebhooksResponse, error) {
+	var resp ListGlobalWebhooksResponse
+	if err := c.request(ctx, http.MethodGet, "/v2/webhooks", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetGlobalWebhook retrieves an account-level webhook.
+func (c *Client) GetGlobalWebhook(ctx context.Context, webhookID string) (*Webhook, error) {
+	path := fmt.Sprintf("/v2/webhooks/%s", webhookID)
+	var webhook Webhook
+	if err := c.request(ctx, http.MethodGet, path, nil, nil, &webhook); err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// UpdateGlobalWebhook updates an account-level webhook.
+func (c *Client) UpdateGlobalWebhook(ctx context.Context, webhookID string, req *UpdateWebhookRequest) (*Webhook, error) {
+	path := fmt.Sprintf("/v2/webhooks/%s", webhookID)
+	var webhook Webhook
+	if err := c.request(ctx, http.MethodPut, path, nil, req, &webhook); err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// DeleteGlobalWebhook removes an account-level webhook.
+func (c *Client) DeleteGlobalWebhook(ctx context.Context, webhookID string) error {
+	path := fmt.Sprintf("/v2/webhooks/%s", webhookID)
+	return c.request(ctx, http.MethodDelete, path, nil, nil, nil)
+}
@@ -221,3 +221,186 @@ func TestTestWebhook(t *testing.T) {
 		}
 	})
 }
+
+func TestGlobalWebhooks(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("expected POST, got %s", r.Method)
+			}
+			if r.URL.Path != "/v2/webhooks" {
+				t.Errorf("expected path /v2/webhooks, got %s", r.URL.Path)
+			}
+			var req CreateWebhookRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(Webhook{ID: "webhook_1", URL: req.URL, IsActive: true})
+		})
+		defer server.Close()
+
+		webhook, err := client.CreateGlobalWebhook(context.Background(), &CreateWebhookRequest{URL: "https://example.com/all"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if webhook.ID != "webhook_1" || webhook.AlertID != "" {
+			t.Errorf("unexpected webhook %+v", webhook)
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet || r.URL.Path != "/v2/webhooks" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			json.NewEncoder(w).Encode(ListGlobalWebhooksResponse{Webhooks: []Webhook{{ID: "webhook_1"}, {ID: "webhook_2"}}})
+		})
+		defer server.Close()
+
+		resp, err := client.ListGlobalWebhooks(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(resp.Webhooks) != 2 {
+			t.Errorf("expected 2 webhooks, got %d", len(resp.Webhooks))
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodDelete || r.URL.Path != "/v2/webhooks/webhook_1" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		})
+		defer server.Close()
+
+		if err := client.DeleteGlobalWebhook(context.Background(), "webhook_1"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestRotateWebhookSecret(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/v2/alerts/alert_123/webhook/rotate-secret" {
+			t.Errorf("expected path /v2/alerts/alert_123/webhook/rotate-secret, got %s", r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(RotateWebhookSecretResponse{Secret: "whsec_new", RotatedAt: time.Now()})
+	})
+	defer server.Close()
+
+	resp, err := client.RotateWebhookSecret(context.Background(), "alert_123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Secret != "whsec_new" {
+		t.Errorf("expected secret whsec_new, got %s", resp.Secret)
+	}
+}
+
+func TestCreateWebhook_PayloadOptions(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		payload, _ := body["payload"].(map[string]any)
+		if payload["format"] != "slack" {
+			t.Errorf("expected slack payload format, got %v", body["payload"])
+		}
+		json.NewEncoder(w).Encode(Webhook{ID: "webhook_1", Payload: &PayloadOptions{Format: PayloadFormatSlack}})
+	})
+	defer server.Close()
+
+	webhook, err := client.CreateWebhook(context.Background(), "alert_123", &CreateWebhookRequest{
+		URL:     "https://hooks.slack.com/services/T000/B000/XXX",
+		Payload: &PayloadOptions{Format: PayloadFormatSlack},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if webhook.Payload == nil || webhook.Payload.Format != PayloadFormatSlack {
+		t.Errorf("unexpected payload options %+v", webhook.Payload)
+	}
+}
+
+func TestSetWebhookActive(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/v2/alerts/alert_123/webhook/active" {
+			t.Errorf("expected path /v2/alerts/alert_123/webhook/active, got %s", r.URL.Path)
+		}
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body) != 1 || body["is_active"] != false {
+			t.Errorf("expected only is_active=false, got %v", body)
+		}
+		json.NewEncoder(w).Encode(Webhook{ID: "webhook_789", URL: "https://example.com/webhook", IsActive: false})
+	})
+	defer server.Close()
+
+	webhook, err := client.SetWebhookActive(context.Background(), "alert_123", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if webhook.IsActive {
+		t.Error("expected webhook to be paused")
+	}
+}
+
+func TestGetWebhookStats(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/alerts/alert_123/webhook/stats" {
+			t.Errorf("expected path /v2/alerts/alert_123/webhook/stats, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("period"); got != "7d" {
+			t.Errorf("expected period=7d, got %q", got)
+		}
+		w.Write([]byte(`{
+			"period": "7d",
+			"deliveries": 200,
+			"successes": 190,
+			"failures": 10,
+			"success_rate": 0.95,
+			"p95_latency_ms": 412.5,
+			"failures_by_status": {"500": 6, "503": 2},
+			"timeouts": 2
+		}`))
+	})
+	defer server.Close()
+
+	stats, err := client.GetWebhookStats(context.Background(), "alert_123", StatsPeriodWeek)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stats.FailuresByStatus[500] != 6 || stats.Timeouts != 2 {
+		t.Errorf("unexpected failure breakdown %+v", stats)
+	}
+	if got := stats.P95Latency(); got != 412500*time.Microsecond {
+		t.Errorf("expected p95 latency 412.5ms, got %v", got)
+	}
+}
+
+func TestGetWebhookSecret(t *testing.T) {
+	client, server := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/v2/alerts/alert_123/webhook/secret" {
+			t.Errorf("expected path /v2/alerts/alert_123/webhook/secret, got %s", r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(WebhookSecretResponse{Secret: "whsec_current", KeyID: "key_2"})
+	})
+	defer server.Close()
+
+	resp, err := client.GetWebhookSecret(context.Background(), "alert_123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Secret != "whsec_current" || resp.KeyID != "key_2" {
+		t.Errorf("unexpected secret response %+v", resp)
+	}
+}